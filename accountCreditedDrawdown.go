@@ -90,6 +90,11 @@ func (creditDD *AccountCreditedDrawdown) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on AccountCreditedDrawdown and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (creditDD *AccountCreditedDrawdown) Validate() error {
+	return creditDD.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on AccountCreditedDrawdown following opts, which may be nil
+func (creditDD *AccountCreditedDrawdown) validate(opts *ValidateOpts) error {
 	if err := creditDD.fieldInclusion(); err != nil {
 		return err
 	}
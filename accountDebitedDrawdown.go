@@ -135,6 +135,11 @@ func (debitDD *AccountDebitedDrawdown) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on AccountDebitedDrawdown and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (debitDD *AccountDebitedDrawdown) Validate() error {
+	return debitDD.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on AccountDebitedDrawdown following opts, which may be nil
+func (debitDD *AccountDebitedDrawdown) validate(opts *ValidateOpts) error {
 	if err := debitDD.fieldInclusion(); err != nil {
 		return err
 	}
@@ -151,19 +156,22 @@ func (debitDD *AccountDebitedDrawdown) Validate() error {
 	default:
 		return fieldError("IdentificationCode", ErrIdentificationCode, debitDD.IdentificationCode)
 	}
-	if err := debitDD.isAlphanumeric(debitDD.Identifier); err != nil {
+	if err := debitDD.isAlphanumeric(opts, debitDD.Identifier); err != nil {
+		return fieldError("Identifier", err, debitDD.Identifier)
+	}
+	if err := debitDD.validateIBAN(opts, debitDD.Identifier); err != nil {
 		return fieldError("Identifier", err, debitDD.Identifier)
 	}
-	if err := debitDD.isAlphanumeric(debitDD.Name); err != nil {
+	if err := debitDD.isAlphanumeric(opts, debitDD.Name); err != nil {
 		return fieldError("Name", err, debitDD.Name)
 	}
-	if err := debitDD.isAlphanumeric(debitDD.Address.AddressLineOne); err != nil {
+	if err := debitDD.isAlphanumeric(opts, debitDD.Address.AddressLineOne); err != nil {
 		return fieldError("AddressLineOne", err, debitDD.Address.AddressLineOne)
 	}
-	if err := debitDD.isAlphanumeric(debitDD.Address.AddressLineTwo); err != nil {
+	if err := debitDD.isAlphanumeric(opts, debitDD.Address.AddressLineTwo); err != nil {
 		return fieldError("AddressLineTwo", err, debitDD.Address.AddressLineTwo)
 	}
-	if err := debitDD.isAlphanumeric(debitDD.Address.AddressLineThree); err != nil {
+	if err := debitDD.isAlphanumeric(opts, debitDD.Address.AddressLineThree); err != nil {
 		return fieldError("AddressLineThree", err, debitDD.Address.AddressLineThree)
 	}
 	return nil
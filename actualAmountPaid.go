@@ -101,13 +101,18 @@ func (aap *ActualAmountPaid) Format(options FormatOptions) string {
 // The first error encountered is returned and stops that parsing.
 // Currency Code and Amount are mandatory for each set of remittance data.
 func (aap *ActualAmountPaid) Validate() error {
+	return aap.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on ActualAmountPaid following opts, which may be nil
+func (aap *ActualAmountPaid) validate(opts *ValidateOpts) error {
 	if err := aap.fieldInclusion(); err != nil {
 		return err
 	}
 	if aap.tag != TagActualAmountPaid {
 		return fieldError("tag", ErrValidTagForType, aap.tag)
 	}
-	if err := aap.isCurrencyCode(aap.RemittanceAmount.CurrencyCode); err != nil {
+	if err := aap.isCurrencyCode(opts, aap.RemittanceAmount.CurrencyCode); err != nil {
 		return fieldError("CurrencyCode", err, aap.RemittanceAmount.CurrencyCode)
 	}
 	if err := aap.isAmount(aap.RemittanceAmount.Amount); err != nil {
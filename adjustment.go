@@ -135,6 +135,11 @@ func (adj *Adjustment) Format(options FormatOptions) string {
 // The first error encountered is returned and stops that parsing.
 // Adjustment Reason, Credit Debit Indicator, Currency Code and Amount are mandatory.
 func (adj *Adjustment) Validate() error {
+	return adj.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on Adjustment following opts, which may be nil
+func (adj *Adjustment) validate(opts *ValidateOpts) error {
 	if err := adj.fieldInclusion(); err != nil {
 		return err
 	}
@@ -147,7 +152,7 @@ func (adj *Adjustment) Validate() error {
 	if err := adj.isCreditDebitIndicator(adj.CreditDebitIndicator); err != nil {
 		return fieldError("CreditDebitIndicator", err, adj.CreditDebitIndicator)
 	}
-	if err := adj.isCurrencyCode(adj.RemittanceAmount.CurrencyCode); err != nil {
+	if err := adj.isCurrencyCode(opts, adj.RemittanceAmount.CurrencyCode); err != nil {
 		return fieldError("CurrencyCode", err, adj.RemittanceAmount.CurrencyCode)
 	}
 	if err := adj.isAmount(adj.RemittanceAmount.Amount); err != nil {
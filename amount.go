@@ -6,10 +6,14 @@ package wire
 
 import (
 	"encoding/json"
+	"fmt"
 	"strings"
 	"unicode/utf8"
 )
 
+// maxAmountCents is the largest Amount, a penny less than $10 billion
+const maxAmountCents = 999999999999
+
 // Amount (up to a penny less than $10 billion) {2000}
 type Amount struct {
 	// tag
@@ -73,6 +77,11 @@ func (a *Amount) String() string {
 // Validate performs WIRE format rule checks on Amount and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (a *Amount) Validate() error {
+	return a.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on Amount following opts, which may be nil
+func (a *Amount) validate(opts *ValidateOpts) error {
 	if err := a.fieldInclusion(); err != nil {
 		return err
 	}
@@ -98,3 +107,27 @@ func (a *Amount) fieldInclusion() error {
 func (a *Amount) AmountField() string {
 	return a.numericStringField(a.Amount, 12)
 }
+
+// Cents returns Amount as a number of cents, such as 123456 for 000000123456
+func (a *Amount) Cents() (int64, error) {
+	amount := strings.TrimSpace(a.Amount)
+	if amount == "" {
+		return 0, fieldError("Amount", ErrFieldRequired)
+	}
+	cents, err := parseMinorUnits(amount, 0, 0)
+	if err != nil {
+		return 0, fieldError("Amount", err, a.Amount)
+	}
+	return cents, nil
+}
+
+// SetCents sets Amount to a number of cents, right justified with leading zeroes. An error is returned
+// and Amount is left unchanged for negative amounts and amounts of $10 billion or more, which don't fit
+// in the 12 digits of Amount.
+func (a *Amount) SetCents(cents int64) error {
+	if cents < 0 || cents > maxAmountCents {
+		return fieldError("Amount", ErrNonAmount, cents)
+	}
+	a.Amount = fmt.Sprintf("%012d", cents)
+	return nil
+}
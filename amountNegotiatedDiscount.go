@@ -100,13 +100,18 @@ func (nd *AmountNegotiatedDiscount) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on AmountNegotiatedDiscount and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (nd *AmountNegotiatedDiscount) Validate() error {
+	return nd.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on AmountNegotiatedDiscount following opts, which may be nil
+func (nd *AmountNegotiatedDiscount) validate(opts *ValidateOpts) error {
 	if err := nd.fieldInclusion(); err != nil {
 		return err
 	}
 	if nd.tag != TagAmountNegotiatedDiscount {
 		return fieldError("tag", ErrValidTagForType, nd.tag)
 	}
-	if err := nd.isCurrencyCode(nd.RemittanceAmount.CurrencyCode); err != nil {
+	if err := nd.isCurrencyCode(opts, nd.RemittanceAmount.CurrencyCode); err != nil {
 		return fieldError("CurrencyCode", err, nd.RemittanceAmount.CurrencyCode)
 	}
 	if err := nd.isAmount(nd.RemittanceAmount.Amount); err != nil {
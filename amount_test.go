@@ -87,3 +87,32 @@ func TestAmountTagError(t *testing.T) {
 
 	require.EqualError(t, err, fieldError("tag", ErrValidTagForType, a.tag).Error())
 }
+
+// TestAmountCents validates reading and setting Amount in cents
+func TestAmountCents(t *testing.T) {
+	a := mockAmount()
+	cents, err := a.Cents()
+	require.NoError(t, err)
+	require.Equal(t, int64(1234567), cents)
+
+	require.NoError(t, a.SetCents(999999999999))
+	require.Equal(t, "999999999999", a.Amount)
+	require.NoError(t, a.Validate())
+
+	require.NoError(t, a.SetCents(5))
+	require.Equal(t, "000000000005", a.Amount)
+	require.Equal(t, "{2000}000000000005", a.String())
+
+	// negative amounts and amounts of more than 12 digits are rejected, leaving Amount unchanged
+	require.ErrorIs(t, a.SetCents(-5), ErrNonAmount)
+	require.ErrorIs(t, a.SetCents(1000000000000), ErrNonAmount)
+	require.Equal(t, "000000000005", a.Amount)
+
+	a.Amount = "00000Z030022"
+	_, err = a.Cents()
+	require.ErrorIs(t, err, ErrNonAmount)
+
+	a.Amount = ""
+	_, err = a.Cents()
+	require.ErrorIs(t, err, ErrFieldRequired)
+}
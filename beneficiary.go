@@ -131,6 +131,11 @@ func (ben *Beneficiary) Format(options FormatOptions) string {
 // The first error encountered is returned and stops that parsing.
 // If ID Code is present, Identifier is mandatory and vice versa.
 func (ben *Beneficiary) Validate() error {
+	return ben.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on Beneficiary following opts, which may be nil
+func (ben *Beneficiary) validate(opts *ValidateOpts) error {
 	if ben.tag != TagBeneficiary {
 		return fieldError("tag", ErrValidTagForType, ben.tag)
 	}
@@ -148,21 +153,24 @@ func (ben *Beneficiary) Validate() error {
 			return fieldError("IdentificationCode", err, ben.Personal.IdentificationCode)
 		}
 		// Identifier text must only contain allowed characters
-		if err := ben.isAlphanumeric(ben.Personal.Identifier); err != nil {
+		if err := ben.isAlphanumeric(opts, ben.Personal.Identifier); err != nil {
+			return fieldError("Identifier", err, ben.Personal.Identifier)
+		}
+		if err := ben.validateAccountIdentifier(opts, ben.Personal.IdentificationCode, ben.Personal.Identifier); err != nil {
 			return fieldError("Identifier", err, ben.Personal.Identifier)
 		}
 	}
 
-	if err := ben.isAlphanumeric(ben.Personal.Name); err != nil {
+	if err := ben.isAlphanumeric(opts, ben.Personal.Name); err != nil {
 		return fieldError("Name", err, ben.Personal.Name)
 	}
-	if err := ben.isAlphanumeric(ben.Personal.Address.AddressLineOne); err != nil {
+	if err := ben.isAlphanumeric(opts, ben.Personal.Address.AddressLineOne); err != nil {
 		return fieldError("AddressLineOne", err, ben.Personal.Address.AddressLineOne)
 	}
-	if err := ben.isAlphanumeric(ben.Personal.Address.AddressLineTwo); err != nil {
+	if err := ben.isAlphanumeric(opts, ben.Personal.Address.AddressLineTwo); err != nil {
 		return fieldError("AddressLineTwo", err, ben.Personal.Address.AddressLineTwo)
 	}
-	if err := ben.isAlphanumeric(ben.Personal.Address.AddressLineThree); err != nil {
+	if err := ben.isAlphanumeric(opts, ben.Personal.Address.AddressLineThree); err != nil {
 		return fieldError("AddressLineThree", err, ben.Personal.Address.AddressLineThree)
 	}
 	return nil
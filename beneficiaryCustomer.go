@@ -136,28 +136,36 @@ func (bc *BeneficiaryCustomer) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on BeneficiaryCustomer and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (bc *BeneficiaryCustomer) Validate() error {
+	return bc.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on BeneficiaryCustomer following opts, which may be nil
+func (bc *BeneficiaryCustomer) validate(opts *ValidateOpts) error {
 	if err := bc.fieldInclusion(); err != nil {
 		return err
 	}
 	if bc.tag != TagBeneficiaryCustomer {
 		return fieldError("tag", ErrValidTagForType, bc.tag)
 	}
-	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftFieldTag); err != nil {
+	if err := bc.isAlphanumeric(opts, bc.CoverPayment.SwiftFieldTag); err != nil {
 		return fieldError("SwiftFieldTag", err, bc.CoverPayment.SwiftFieldTag)
 	}
-	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineOne); err != nil {
+	if err := bc.isAlphanumeric(opts, bc.CoverPayment.SwiftLineOne); err != nil {
+		return fieldError("SwiftLineOne", err, bc.CoverPayment.SwiftLineOne)
+	}
+	if err := bc.validateSwiftAccountLine(opts, bc.CoverPayment.SwiftLineOne); err != nil {
 		return fieldError("SwiftLineOne", err, bc.CoverPayment.SwiftLineOne)
 	}
-	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineTwo); err != nil {
+	if err := bc.isAlphanumeric(opts, bc.CoverPayment.SwiftLineTwo); err != nil {
 		return fieldError("SwiftLineTwo", err, bc.CoverPayment.SwiftLineTwo)
 	}
-	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineThree); err != nil {
+	if err := bc.isAlphanumeric(opts, bc.CoverPayment.SwiftLineThree); err != nil {
 		return fieldError("SwiftLineThree", err, bc.CoverPayment.SwiftLineThree)
 	}
-	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineFour); err != nil {
+	if err := bc.isAlphanumeric(opts, bc.CoverPayment.SwiftLineFour); err != nil {
 		return fieldError("SwiftLineFour", err, bc.CoverPayment.SwiftLineFour)
 	}
-	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineFive); err != nil {
+	if err := bc.isAlphanumeric(opts, bc.CoverPayment.SwiftLineFive); err != nil {
 		return fieldError("SwiftLineFive", err, bc.CoverPayment.SwiftLineFive)
 	}
 	return nil
@@ -128,11 +128,16 @@ func (bfi *BeneficiaryFI) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on BeneficiaryFI and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (bfi *BeneficiaryFI) Validate() error {
+	return bfi.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on BeneficiaryFI following opts, which may be nil
+func (bfi *BeneficiaryFI) validate(opts *ValidateOpts) error {
 	if bfi.tag != TagBeneficiaryFI {
 		return fieldError("tag", ErrValidTagForType, bfi.tag)
 	}
 
-	if err := bfi.FinancialInstitution.Validate(); err != nil {
+	if err := bfi.FinancialInstitution.validate(opts); err != nil {
 		return err
 	}
 
@@ -47,6 +47,33 @@ func TestBeneficiaryFIIdentificationCodeFI(t *testing.T) {
 	require.EqualError(t, err, fieldError("IdentificationCode", ErrIdentificationCode, bfi.FinancialInstitution.IdentificationCode).Error())
 }
 
+// TestBeneficiaryFIFEDRoutingNumber validates the check digit of a BeneficiaryFI identified by FEDRoutingNumber
+func TestBeneficiaryFIFEDRoutingNumber(t *testing.T) {
+	bfi := mockBeneficiaryFI()
+	bfi.FinancialInstitution.IdentificationCode = FEDRoutingNumber
+	bfi.FinancialInstitution.Identifier = "021000021"
+	require.NoError(t, bfi.Validate())
+
+	bfi.FinancialInstitution.Identifier = "021000012"
+	err := bfi.Validate()
+	require.EqualError(t, err, fieldError("Identifier", ErrABANumber, bfi.FinancialInstitution.Identifier).Error())
+
+	require.NoError(t, bfi.validate(&ValidateOpts{SkipRules: []string{RuleABACheckDigit}}))
+}
+
+func TestBeneficiaryFISWIFTBankIdentifierCode(t *testing.T) {
+	bfi := mockBeneficiaryFI()
+	bfi.FinancialInstitution.IdentificationCode = SWIFTBankIdentifierCode
+	bfi.FinancialInstitution.Identifier = "CITIUS33XXX"
+	require.NoError(t, bfi.Validate())
+
+	bfi.FinancialInstitution.Identifier = "CITI33"
+	err := bfi.Validate()
+	require.EqualError(t, err, fieldError("Identifier", ErrBIC, bfi.FinancialInstitution.Identifier).Error())
+
+	require.NoError(t, bfi.validate(&ValidateOpts{SkipRules: []string{RuleBIC}}))
+}
+
 // TestBeneficiaryFIIdentifierAlphaNumeric validates BeneficiaryFI Identifier is alphanumeric
 func TestBeneficiaryFIIdentifierAlphaNumeric(t *testing.T) {
 	bfi := mockBeneficiaryFI()
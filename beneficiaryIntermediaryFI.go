@@ -129,11 +129,16 @@ func (bifi *BeneficiaryIntermediaryFI) Format(options FormatOptions) string {
 // The first error encountered is returned and stops that parsing.
 // If ID Code is present, Identifier is mandatory and vice versa.
 func (bifi *BeneficiaryIntermediaryFI) Validate() error {
+	return bifi.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on BeneficiaryIntermediaryFI following opts, which may be nil
+func (bifi *BeneficiaryIntermediaryFI) validate(opts *ValidateOpts) error {
 	if bifi.tag != TagBeneficiaryIntermediaryFI {
 		return fieldError("tag", ErrValidTagForType, bifi.tag)
 	}
 
-	if err := bifi.FinancialInstitution.Validate(); err != nil {
+	if err := bifi.FinancialInstitution.validate(opts); err != nil {
 		return err
 	}
 
@@ -92,10 +92,15 @@ func (br *BeneficiaryReference) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on BeneficiaryReference and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (br *BeneficiaryReference) Validate() error {
+	return br.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on BeneficiaryReference following opts, which may be nil
+func (br *BeneficiaryReference) validate(opts *ValidateOpts) error {
 	if br.tag != TagBeneficiaryReference {
 		return fieldError("tag", ErrValidTagForType, br.tag)
 	}
-	if err := br.isAlphanumeric(br.BeneficiaryReference); err != nil {
+	if err := br.isAlphanumeric(opts, br.BeneficiaryReference); err != nil {
 		return fieldError("BeneficiaryReference", err, br.BeneficiaryReference)
 	}
 	return nil
@@ -0,0 +1,422 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/moov-io/base"
+)
+
+// messageBuilder holds the setters shared by the builder of each business function code. B is the
+// builder embedding messageBuilder, which each setter returns so calls can be chained.
+type messageBuilder[B any] struct {
+	self B
+	fwm  *FEDWireMessage
+
+	// errs holds the errors returned by setters, which are reported by Build
+	errs []error
+}
+
+// newMessageBuilder returns a messageBuilder for a FEDWireMessage with the businessFunctionCode,
+// a default SenderSupplied and a TypeSubType of typeCode and subTypeCode
+func newMessageBuilder[B any](self B, businessFunctionCode, typeCode, subTypeCode string) messageBuilder[B] {
+	tst := NewTypeSubType()
+	tst.TypeCode = typeCode
+	tst.SubTypeCode = subTypeCode
+
+	bfc := NewBusinessFunctionCode()
+	bfc.BusinessFunctionCode = businessFunctionCode
+
+	return messageBuilder[B]{
+		self: self,
+		fwm: &FEDWireMessage{
+			SenderSupplied:       NewSenderSupplied(),
+			TypeSubType:          tst,
+			BusinessFunctionCode: bfc,
+		},
+	}
+}
+
+// SenderSupplied sets {1500} SenderSupplied, replacing the default from NewSenderSupplied
+func (b *messageBuilder[B]) SenderSupplied(ss *SenderSupplied) B {
+	b.fwm.SenderSupplied = ss
+	return b.self
+}
+
+// UserRequestCorrelation sets the UserRequestCorrelation of {1500} SenderSupplied
+func (b *messageBuilder[B]) UserRequestCorrelation(correlation string) B {
+	if b.fwm.SenderSupplied == nil {
+		b.fwm.SenderSupplied = NewSenderSupplied()
+	}
+	b.fwm.SenderSupplied.UserRequestCorrelation = correlation
+	return b.self
+}
+
+// TypeSubType sets {1510} TypeSubType, such as FundsTransfer and BasicFundsTransfer
+func (b *messageBuilder[B]) TypeSubType(typeCode, subTypeCode string) B {
+	if b.fwm.TypeSubType == nil {
+		b.fwm.TypeSubType = NewTypeSubType()
+	}
+	b.fwm.TypeSubType.TypeCode = typeCode
+	b.fwm.TypeSubType.SubTypeCode = subTypeCode
+	return b.self
+}
+
+// IMAD sets {1520} InputMessageAccountabilityData, which can be created by an IMADGenerator
+func (b *messageBuilder[B]) IMAD(imad *InputMessageAccountabilityData) B {
+	b.fwm.InputMessageAccountabilityData = imad
+	return b.self
+}
+
+// Amount sets {2000} Amount in cents, so 123456 is $1,234.56
+func (b *messageBuilder[B]) Amount(cents int64) B {
+	amt := NewAmount()
+	if err := amt.SetCents(cents); err != nil {
+		b.errs = append(b.errs, err)
+		return b.self
+	}
+	b.fwm.Amount = amt
+	return b.self
+}
+
+// SenderDI sets {3100} SenderDepositoryInstitution
+func (b *messageBuilder[B]) SenderDI(abaNumber, shortName string) B {
+	sdi := NewSenderDepositoryInstitution()
+	sdi.SenderABANumber = abaNumber
+	sdi.SenderShortName = shortName
+	b.fwm.SenderDepositoryInstitution = sdi
+	return b.self
+}
+
+// ReceiverDI sets {3400} ReceiverDepositoryInstitution
+func (b *messageBuilder[B]) ReceiverDI(abaNumber, shortName string) B {
+	rdi := NewReceiverDepositoryInstitution()
+	rdi.ReceiverABANumber = abaNumber
+	rdi.ReceiverShortName = shortName
+	b.fwm.ReceiverDepositoryInstitution = rdi
+	return b.self
+}
+
+// SenderReference sets {3320} SenderReference
+func (b *messageBuilder[B]) SenderReference(reference string) B {
+	sr := NewSenderReference()
+	sr.SenderReference = reference
+	b.fwm.SenderReference = sr
+	return b.self
+}
+
+// PreviousMessageIdentifier sets {3500} PreviousMessageIdentifier, which is mandatory for reversals
+func (b *messageBuilder[B]) PreviousMessageIdentifier(identifier string) B {
+	pmi := NewPreviousMessageIdentifier()
+	pmi.PreviousMessageIdentifier = identifier
+	b.fwm.PreviousMessageIdentifier = pmi
+	return b.self
+}
+
+// BeneficiaryIntermediaryFI sets {4000} BeneficiaryIntermediaryFI
+func (b *messageBuilder[B]) BeneficiaryIntermediaryFI(fi FinancialInstitution) B {
+	bifi := NewBeneficiaryIntermediaryFI()
+	bifi.FinancialInstitution = fi
+	b.fwm.BeneficiaryIntermediaryFI = bifi
+	return b.self
+}
+
+// BeneficiaryFI sets {4100} BeneficiaryFI
+func (b *messageBuilder[B]) BeneficiaryFI(fi FinancialInstitution) B {
+	bfi := NewBeneficiaryFI()
+	bfi.FinancialInstitution = fi
+	b.fwm.BeneficiaryFI = bfi
+	return b.self
+}
+
+// Beneficiary sets {4200} Beneficiary
+func (b *messageBuilder[B]) Beneficiary(personal Personal) B {
+	ben := NewBeneficiary()
+	ben.Personal = personal
+	b.fwm.Beneficiary = ben
+	return b.self
+}
+
+// BeneficiaryReference sets {4320} BeneficiaryReference
+func (b *messageBuilder[B]) BeneficiaryReference(reference string) B {
+	br := NewBeneficiaryReference()
+	br.BeneficiaryReference = reference
+	b.fwm.BeneficiaryReference = br
+	return b.self
+}
+
+// Originator sets {5000} Originator
+func (b *messageBuilder[B]) Originator(personal Personal) B {
+	o := NewOriginator()
+	o.Personal = personal
+	b.fwm.Originator = o
+	return b.self
+}
+
+// OriginatorFI sets {5100} OriginatorFI
+func (b *messageBuilder[B]) OriginatorFI(fi FinancialInstitution) B {
+	ofi := NewOriginatorFI()
+	ofi.FinancialInstitution = fi
+	b.fwm.OriginatorFI = ofi
+	return b.self
+}
+
+// InstructingFI sets {5200} InstructingFI
+func (b *messageBuilder[B]) InstructingFI(fi FinancialInstitution) B {
+	ifi := NewInstructingFI()
+	ifi.FinancialInstitution = fi
+	b.fwm.InstructingFI = ifi
+	return b.self
+}
+
+// OriginatorToBeneficiary sets {6000} OriginatorToBeneficiary, which holds up to four lines
+func (b *messageBuilder[B]) OriginatorToBeneficiary(lines ...string) B {
+	if len(lines) > 4 {
+		b.errs = append(b.errs, fieldError("OriginatorToBeneficiary", ErrValidLength, len(lines)))
+		return b.self
+	}
+	lines = append(lines, make([]string, 4-len(lines))...)
+
+	ob := NewOriginatorToBeneficiary()
+	ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour = lines[0], lines[1], lines[2], lines[3]
+	b.fwm.OriginatorToBeneficiary = ob
+	return b.self
+}
+
+// With calls set with the FEDWireMessage being built, so tags without a setter can be added
+func (b *messageBuilder[B]) With(set func(fwm *FEDWireMessage)) B {
+	set(b.fwm)
+	return b.self
+}
+
+// Build returns the FEDWireMessage once it is valid. Every missing mandatory tag is returned in a
+// base.ErrorList of *FieldError before any other error is looked for, otherwise the errors of
+// FEDWireMessage.ValidateAll are returned.
+//
+// The FEDWireMessage returned is a copy, so later calls to the builder don't change it.
+func (b *messageBuilder[B]) Build() (*FEDWireMessage, error) {
+	var errs base.ErrorList
+	for _, err := range b.errs {
+		errs.Add(newMessageFieldError("", err))
+	}
+	if !errs.Empty() {
+		return nil, errs
+	}
+
+	for _, err := range b.fwm.missingTags() {
+		errs.Add(newMessageFieldError("", err))
+	}
+	if !errs.Empty() {
+		return nil, errs
+	}
+
+	if err := b.fwm.ValidateAll(); err != nil {
+		return nil, err
+	}
+	return b.fwm.clone(), nil
+}
+
+// missingTags returns an error for each tag which is missing and is mandatory for every FEDWireMessage, for its
+// business function code, or because of another tag which is present, such as {4200} Beneficiary for {4100} BeneficiaryFI
+func (fwm *FEDWireMessage) missingTags() []error {
+	var missing []error
+	add := func(err error) {
+		if !errors.Is(err, ErrFieldRequired) {
+			return
+		}
+		for i := range missing {
+			if missing[i].Error() == err.Error() {
+				return
+			}
+		}
+		missing = append(missing, err)
+	}
+
+	for _, rule := range mandatoryRules {
+		if rule.field != "BusinessFunctionCode" {
+			add(rule.run(fwm))
+		}
+	}
+	if fwm.TypeSubType == nil || fwm.BusinessFunctionCode == nil {
+		add(fwm.validateBusinessFunctionCode())
+		return missing
+	}
+
+	var tags []error
+	switch fwm.BusinessFunctionCode.BusinessFunctionCode {
+	case BankTransfer:
+		tags = []error{fwm.checkPreviousMessageIdentifier()}
+	case CustomerTransfer:
+		tags = fwm.missingCustomerTransferTags()
+	case CustomerTransferPlus:
+		tags = fwm.missingCustomerTransferPlusTags()
+	case DrawdownResponse:
+		tags = fwm.missingDrawdownResponseTags()
+	case BankDrawDownRequest:
+		tags = fwm.missingBankDrawdownRequestTags()
+	case CustomerCorporateDrawdownRequest:
+		tags = fwm.missingCustomerCorporateDrawdownRequestTags()
+	}
+	for _, err := range tags {
+		add(err)
+	}
+	for _, rule := range crossTagRules {
+		add(rule.run(fwm))
+	}
+	return missing
+}
+
+// BankTransferBuilder builds a BankTransfer (BTR) FEDWireMessage
+type BankTransferBuilder struct {
+	messageBuilder[*BankTransferBuilder]
+}
+
+// NewBankTransfer returns a BankTransferBuilder for a basic funds transfer. {4200} Beneficiary and
+// {5000} Originator may not use SWIFTBICORBEIANDAccountNumber, and tags such as {3710} InstructedAmount
+// and {3720} ExchangeRate are prohibited.
+func NewBankTransfer() *BankTransferBuilder {
+	b := &BankTransferBuilder{}
+	b.messageBuilder = newMessageBuilder(b, BankTransfer, FundsTransfer, BasicFundsTransfer)
+	return b
+}
+
+// Beneficiary sets {4200} Beneficiary. An error is recorded when its IdentificationCode is
+// SWIFTBICORBEIANDAccountNumber, which a BankTransfer prohibits.
+func (b *BankTransferBuilder) Beneficiary(personal Personal) *BankTransferBuilder {
+	return b.checkProhibitedTags(func() { b.messageBuilder.Beneficiary(personal) })
+}
+
+// Originator sets {5000} Originator. An error is recorded when its IdentificationCode is
+// SWIFTBICORBEIANDAccountNumber, which a BankTransfer prohibits.
+func (b *BankTransferBuilder) Originator(personal Personal) *BankTransferBuilder {
+	return b.checkProhibitedTags(func() { b.messageBuilder.Originator(personal) })
+}
+
+// With calls set with the FEDWireMessage being built. An error is recorded when set adds a tag
+// which a BankTransfer prohibits, such as {3710} InstructedAmount.
+func (b *BankTransferBuilder) With(set func(fwm *FEDWireMessage)) *BankTransferBuilder {
+	return b.checkProhibitedTags(func() { b.messageBuilder.With(set) })
+}
+
+// checkProhibitedTags calls set and records an error when it added a tag prohibited on a BankTransfer,
+// so the error is reported by Build even when the tag is later removed
+func (b *BankTransferBuilder) checkProhibitedTags(set func()) *BankTransferBuilder {
+	before := b.fwm.checkProhibitedBankTransferTags()
+	set()
+	if err := b.fwm.checkProhibitedBankTransferTags(); err != nil && (before == nil || err.Error() != before.Error()) {
+		b.errs = append(b.errs, err)
+	}
+	return b
+}
+
+// CustomerTransferBuilder builds a CustomerTransfer (CTR) FEDWireMessage
+type CustomerTransferBuilder struct {
+	messageBuilder[*CustomerTransferBuilder]
+}
+
+// NewCustomerTransfer returns a CustomerTransferBuilder for a basic funds transfer. {4200} Beneficiary
+// and {5000} Originator are mandatory.
+func NewCustomerTransfer() *CustomerTransferBuilder {
+	b := &CustomerTransferBuilder{}
+	b.messageBuilder = newMessageBuilder(b, CustomerTransfer, FundsTransfer, BasicFundsTransfer)
+	return b
+}
+
+// InstructedAmount sets {3710} InstructedAmount from an amount in the minor unit of currencyCode
+func (b *CustomerTransferBuilder) InstructedAmount(currencyCode string, minor int64) *CustomerTransferBuilder {
+	b.errs = append(b.errs, setInstructedAmount(b.fwm, currencyCode, minor)...)
+	return b
+}
+
+// ExchangeRate sets {3720} ExchangeRate, rounded to decimals
+func (b *CustomerTransferBuilder) ExchangeRate(rate *big.Rat, decimals int) *CustomerTransferBuilder {
+	b.errs = append(b.errs, setExchangeRate(b.fwm, rate, decimals)...)
+	return b
+}
+
+// CustomerTransferPlusBuilder builds a CustomerTransferPlus (CTP) FEDWireMessage
+type CustomerTransferPlusBuilder struct {
+	messageBuilder[*CustomerTransferPlusBuilder]
+}
+
+// NewCustomerTransferPlus returns a CustomerTransferPlusBuilder for a basic funds transfer. {4200} Beneficiary
+// and either {5000} Originator or {5010} OriginatorOptionF are mandatory, along with the tags required
+// by the {3610} LocalInstrument when it is set.
+func NewCustomerTransferPlus() *CustomerTransferPlusBuilder {
+	b := &CustomerTransferPlusBuilder{}
+	b.messageBuilder = newMessageBuilder(b, CustomerTransferPlus, FundsTransfer, BasicFundsTransfer)
+	return b
+}
+
+// LocalInstrument sets {3610} LocalInstrument, such as SequenceBCoverPaymentStructured
+func (b *CustomerTransferPlusBuilder) LocalInstrument(code string) *CustomerTransferPlusBuilder {
+	li := NewLocalInstrument()
+	li.LocalInstrumentCode = code
+	b.fwm.LocalInstrument = li
+	return b
+}
+
+// InstructedAmount sets {3710} InstructedAmount from an amount in the minor unit of currencyCode
+func (b *CustomerTransferPlusBuilder) InstructedAmount(currencyCode string, minor int64) *CustomerTransferPlusBuilder {
+	b.errs = append(b.errs, setInstructedAmount(b.fwm, currencyCode, minor)...)
+	return b
+}
+
+// ExchangeRate sets {3720} ExchangeRate, rounded to decimals
+func (b *CustomerTransferPlusBuilder) ExchangeRate(rate *big.Rat, decimals int) *CustomerTransferPlusBuilder {
+	b.errs = append(b.errs, setExchangeRate(b.fwm, rate, decimals)...)
+	return b
+}
+
+// OriginatorOptionF sets {5010} OriginatorOptionF, which may be used in place of {5000} Originator
+func (b *CustomerTransferPlusBuilder) OriginatorOptionF(oof *OriginatorOptionF) *CustomerTransferPlusBuilder {
+	b.fwm.OriginatorOptionF = oof
+	return b
+}
+
+// UnstructuredAddenda sets {8200} UnstructuredAddenda and its AddendaLength
+func (b *CustomerTransferPlusBuilder) UnstructuredAddenda(addenda string) *CustomerTransferPlusBuilder {
+	ua := NewUnstructuredAddenda()
+	ua.Addenda = addenda
+	ua.AddendaLength = fmt.Sprintf("%04d", len(addenda))
+	b.fwm.UnstructuredAddenda = ua
+	return b
+}
+
+// DrawdownResponseBuilder builds a DrawdownResponse (DRW) FEDWireMessage
+type DrawdownResponseBuilder struct {
+	messageBuilder[*DrawdownResponseBuilder]
+}
+
+// NewDrawdownResponse returns a DrawdownResponseBuilder for a funds transfer honoring a request for credit.
+// {4200} Beneficiary and {5000} Originator are mandatory.
+func NewDrawdownResponse() *DrawdownResponseBuilder {
+	b := &DrawdownResponseBuilder{}
+	b.messageBuilder = newMessageBuilder(b, DrawdownResponse, FundsTransfer, FundsTransferRequestCredit)
+	return b
+}
+
+// setInstructedAmount sets the {3710} InstructedAmount of fwm, returning any error
+func setInstructedAmount(fwm *FEDWireMessage, currencyCode string, minor int64) []error {
+	ia := NewInstructedAmount()
+	if err := ia.SetMinorUnits(currencyCode, minor); err != nil {
+		return []error{fieldError("InstructedAmount", err, minor)}
+	}
+	fwm.InstructedAmount = ia
+	return nil
+}
+
+// setExchangeRate sets the {3720} ExchangeRate of fwm, returning any error
+func setExchangeRate(fwm *FEDWireMessage, rate *big.Rat, decimals int) []error {
+	eRate := NewExchangeRate()
+	if err := eRate.SetRate(rate, decimals); err != nil {
+		return []error{fieldError("ExchangeRate", err, rate)}
+	}
+	fwm.ExchangeRate = eRate
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomerTransferBuilder(t *testing.T) {
+	fwm, err := NewCustomerTransfer().
+		IMAD(mockInputMessageAccountabilityData()).
+		Amount(123456).
+		SenderDI("121042882", "Wells Fargo NA").
+		ReceiverDI("231380104", "Citadel").
+		SenderReference("Sender Reference").
+		InstructedAmount("EUR", 98765).
+		ExchangeRate(big.NewRat(12345, 10000), 4).
+		Beneficiary(mockBeneficiary().Personal).
+		Originator(mockOriginator().Personal).
+		OriginatorToBeneficiary("LineOne", "LineTwo").
+		Build()
+	require.NoError(t, err)
+
+	require.Equal(t, CustomerTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, FundsTransfer+BasicFundsTransfer, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, "000000123456", fwm.Amount.Amount)
+	require.Equal(t, "987,65", fwm.InstructedAmount.Amount)
+	require.Equal(t, "1,2345", fwm.ExchangeRate.ExchangeRate)
+	require.Equal(t, "LineTwo", fwm.OriginatorToBeneficiary.LineTwo)
+	require.Empty(t, fwm.OriginatorToBeneficiary.LineThree)
+	require.NoError(t, fwm.verify())
+}
+
+func TestCustomerTransferBuilder_missing(t *testing.T) {
+	_, err := NewCustomerTransfer().
+		TypeSubType(FundsTransfer, ReversalTransfer).
+		Amount(123456).
+		SenderDI("121042882", "Wells Fargo NA").
+		ReceiverDI("231380104", "Citadel").
+		Build()
+
+	var fields []string
+	for _, detail := range ErrorDetails(err) {
+		require.Equal(t, "WIRE-0000-009", detail.Code)
+		fields = append(fields, detail.Field)
+	}
+	require.Equal(t, []string{"InputMessageAccountabilityData", "Beneficiary", "Originator", "PreviousMessageIdentifier"}, fields)
+}
+
+func TestCustomerTransferBuilder_setterError(t *testing.T) {
+	_, err := NewCustomerTransfer().
+		Amount(-5).
+		InstructedAmount("ZZZ", 100).
+		OriginatorToBeneficiary("1", "2", "3", "4", "5").
+		Build()
+
+	require.True(t, base.Has(err, ErrNonAmount))
+	require.True(t, base.Has(err, ErrNonCurrencyCode))
+	require.True(t, base.Has(err, ErrValidLength))
+	require.Len(t, err, 3)
+}
+
+func TestCustomerTransferPlusBuilder(t *testing.T) {
+	b := NewCustomerTransferPlus().
+		IMAD(mockInputMessageAccountabilityData()).
+		Amount(100).
+		SenderDI("121042882", "Wells Fargo NA").
+		ReceiverDI("231380104", "Citadel").
+		Beneficiary(mockBeneficiary().Personal).
+		OriginatorOptionF(mockOriginatorOptionF()).
+		LocalInstrument(NarrativeText)
+
+	_, err := b.Build()
+	require.True(t, base.Has(err, ErrFieldRequired))
+	require.Equal(t, "UnstructuredAddenda", ErrorDetails(err)[0].Field)
+
+	fwm, err := b.UnstructuredAddenda("Invoice 1234").Build()
+	require.NoError(t, err)
+	require.Equal(t, "0012", fwm.UnstructuredAddenda.AddendaLength)
+}
+
+func TestBankTransferBuilder(t *testing.T) {
+	fi := mockBeneficiaryFI().FinancialInstitution
+
+	b := NewBankTransfer().
+		IMAD(mockInputMessageAccountabilityData()).
+		Amount(500000).
+		SenderDI("121042882", "Wells Fargo NA").
+		ReceiverDI("231380104", "Citadel").
+		BeneficiaryFI(fi).
+		Beneficiary(mockBeneficiary().Personal).
+		OriginatorFI(fi).
+		Originator(mockOriginator().Personal)
+	fwm, err := b.Build()
+	require.NoError(t, err)
+	require.Equal(t, BankTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+
+	// the message built isn't changed by later calls to the builder
+	b.Amount(100).SenderReference("Changed")
+	require.Equal(t, "000000500000", fwm.Amount.Amount)
+	require.Nil(t, fwm.SenderReference)
+
+	// BankTransfer prohibits InstructedAmount
+	_, err = NewBankTransfer().
+		IMAD(mockInputMessageAccountabilityData()).
+		Amount(500000).
+		SenderDI("121042882", "Wells Fargo NA").
+		ReceiverDI("231380104", "Citadel").
+		With(func(fwm *FEDWireMessage) { fwm.InstructedAmount = mockInstructedAmount() }).
+		Build()
+	require.True(t, base.Has(err, ErrInvalidProperty))
+
+	// prohibited tags are recorded as soon as they are set
+	swift := mockBeneficiary().Personal
+	swift.IdentificationCode = SWIFTBICORBEIANDAccountNumber
+	_, err = NewBankTransfer().
+		IMAD(mockInputMessageAccountabilityData()).
+		Amount(500000).
+		SenderDI("121042882", "Wells Fargo NA").
+		ReceiverDI("231380104", "Citadel").
+		Beneficiary(swift).
+		Beneficiary(mockBeneficiary().Personal).
+		Build()
+	details := ErrorDetails(err)
+	require.Len(t, details, 1)
+	require.Equal(t, "Beneficiary.Personal.IdentificationCode", details[0].Field)
+	require.True(t, base.Has(err, ErrInvalidProperty))
+}
+
+func TestDrawdownResponseBuilder(t *testing.T) {
+	b := NewDrawdownResponse().
+		IMAD(mockInputMessageAccountabilityData()).
+		Amount(2500).
+		SenderDI("121042882", "Wells Fargo NA").
+		ReceiverDI("231380104", "Citadel").
+		Beneficiary(mockBeneficiary().Personal)
+
+	_, err := b.Build()
+	require.Len(t, ErrorDetails(err), 1)
+	require.Equal(t, "Originator", ErrorDetails(err)[0].Field)
+
+	fwm, err := b.Originator(mockOriginator().Personal).Build()
+	require.NoError(t, err)
+	require.Equal(t, FundsTransferRequestCredit, fwm.TypeSubType.SubTypeCode)
+}
+
+func TestBuilder_missingCrossTag(t *testing.T) {
+	_, err := NewBankTransfer().
+		IMAD(mockInputMessageAccountabilityData()).
+		Amount(500000).
+		SenderDI("121042882", "Wells Fargo NA").
+		ReceiverDI("231380104", "Citadel").
+		BeneficiaryFI(mockBeneficiaryFI().FinancialInstitution).
+		Build()
+
+	require.Len(t, ErrorDetails(err), 1)
+	require.Equal(t, "Beneficiary", ErrorDetails(err)[0].Field)
+	require.Equal(t, TagBeneficiary, ErrorDetails(err)[0].Tag)
+}
@@ -101,6 +101,11 @@ func (bfc *BusinessFunctionCode) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on BusinessFunctionCode and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (bfc *BusinessFunctionCode) Validate() error {
+	return bfc.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on BusinessFunctionCode following opts, which may be nil
+func (bfc *BusinessFunctionCode) validate(opts *ValidateOpts) error {
 	if err := bfc.fieldInclusion(); err != nil {
 		return err
 	}
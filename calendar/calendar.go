@@ -0,0 +1,166 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package calendar implements the operating day of the Fedwire Funds Service. Each operating day, or
+// input cycle date, is a Federal Reserve business day which opens at 9:00 p.m. Eastern Time on the prior
+// calendar day. Customer transfers and bank transfers have separate cutoff times before the day closes.
+//
+// Operating schedule: https://www.frbservices.org/resources/financial-services/wires/operating-hours.html
+//
+// Holiday schedule: https://www.frbservices.org/about/holiday-schedules
+package calendar
+
+import (
+	"time"
+	// embed the time zone database so Location can be loaded on hosts without one
+	_ "time/tzdata"
+
+	"github.com/rickar/cal/v2"
+	"github.com/rickar/cal/v2/us"
+)
+
+// Location is the America/New_York time zone, which the Fedwire Funds Service uses for its operating day
+var Location = func() *time.Location {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}()
+
+// Business function codes with their own cutoff. They match the BusinessFunctionCode constants of
+// github.com/moov-io/wire, which can't be imported here.
+const (
+	customerTransfer     = "CTR"
+	customerTransferPlus = "CTP"
+	serviceMessage       = "SVC"
+)
+
+// sundayObserved moves holidays on a Sunday to the following Monday. Federal Reserve Banks are open
+// on the Friday before holidays falling on a Saturday.
+var sundayObserved = []cal.AltDay{
+	{Day: time.Sunday, Offset: 1},
+}
+
+// Holidays are the Federal Reserve holidays, when the Fedwire Funds Service is closed
+var Holidays = []*cal.Holiday{
+	us.NewYear.Clone(&cal.Holiday{Observed: sundayObserved}),
+	us.MlkDay,
+	us.PresidentsDay,
+	us.MemorialDay,
+	us.Juneteenth.Clone(&cal.Holiday{Observed: sundayObserved}),
+	us.IndependenceDay.Clone(&cal.Holiday{Observed: sundayObserved}),
+	us.LaborDay,
+	us.ColumbusDay,
+	us.VeteransDay.Clone(&cal.Holiday{Observed: sundayObserved}),
+	us.ThanksgivingDay,
+	us.ChristmasDay.Clone(&cal.Holiday{Observed: sundayObserved}),
+}
+
+// Calendar is the operating schedule of the Fedwire Funds Service. Times are durations from midnight
+// Eastern Time at the start of each input cycle date, so an Open of -3h is 9:00 p.m. on the prior day.
+type Calendar struct {
+	// Open is when the operating day opens
+	Open time.Duration
+	// CustomerTransferCutoff is the last time CustomerTransfer (CTR) and CustomerTransferPlus (CTP)
+	// messages are accepted
+	CustomerTransferCutoff time.Duration
+	// BankTransferCutoff is the last time other funds transfers, such as BankTransfer (BTR), are accepted
+	BankTransferCutoff time.Duration
+	// Close is when the operating day closes, which is the last time service messages (SVC) are accepted
+	Close time.Duration
+
+	business *cal.BusinessCalendar
+}
+
+// New returns a Calendar with the standard operating schedule and Federal Reserve holidays. The day
+// opens at 9:00 p.m. on the prior calendar day, customer transfers are accepted until 6:00 p.m., bank
+// transfers until 6:45 p.m. and the day closes at 7:00 p.m.
+func New() *Calendar {
+	business := cal.NewBusinessCalendar()
+	business.AddHoliday(Holidays...)
+	return &Calendar{
+		Open:                   -3 * time.Hour,
+		CustomerTransferCutoff: 18 * time.Hour,
+		BankTransferCutoff:     18*time.Hour + 45*time.Minute,
+		Close:                  19 * time.Hour,
+		business:               business,
+	}
+}
+
+// defaultCalendar is used by the package functions
+var defaultCalendar = New()
+
+// IsBusinessDay returns true when the date of t in Location is an input cycle date, which is
+// a weekday other than a Federal Reserve holiday
+func (c *Calendar) IsBusinessDay(t time.Time) bool {
+	return c.business.IsWorkday(startOfDay(t))
+}
+
+// NextBusinessDay returns the first business day after the date of t in Location
+func (c *Calendar) NextBusinessDay(t time.Time) time.Time {
+	date := startOfDay(t).AddDate(0, 0, 1)
+	for !c.IsBusinessDay(date) {
+		date = date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+// CycleDate returns the input cycle date of a message released at t, as the start of the day in
+// Location. Messages released after the close, or on a day which isn't a business day, are given
+// the next business day.
+func (c *Calendar) CycleDate(t time.Time) time.Time {
+	date := startOfDay(t)
+	if t.Before(date.Add(c.Close)) && c.IsBusinessDay(date) {
+		return date
+	}
+	return c.NextBusinessDay(date)
+}
+
+// IsOpen returns true when the Fedwire Funds Service is open at t
+func (c *Calendar) IsOpen(t time.Time) bool {
+	date := c.CycleDate(t)
+	return !t.Before(date.Add(c.Open))
+}
+
+// Cutoff returns the last time messages with the business function code bfc, such as "CTR", are
+// accepted on cycleDate
+func (c *Calendar) Cutoff(bfc string, cycleDate time.Time) time.Time {
+	date := startOfDay(cycleDate)
+	switch bfc {
+	case customerTransfer, customerTransferPlus:
+		return date.Add(c.CustomerTransferCutoff)
+	case serviceMessage:
+		return date.Add(c.Close)
+	}
+	return date.Add(c.BankTransferCutoff)
+}
+
+// Allowed returns true when a message with the business function code bfc released at t is accepted
+// on the current operating day, which is open and before the cutoff of bfc
+func (c *Calendar) Allowed(bfc string, t time.Time) bool {
+	return c.IsOpen(t) && t.Before(c.Cutoff(bfc, c.CycleDate(t)))
+}
+
+// IsBusinessDay returns true when the date of t is an input cycle date of the standard Calendar
+func IsBusinessDay(t time.Time) bool {
+	return defaultCalendar.IsBusinessDay(t)
+}
+
+// CycleDate returns the input cycle date of a message released at t using the standard Calendar
+func CycleDate(t time.Time) time.Time {
+	return defaultCalendar.CycleDate(t)
+}
+
+// Allowed returns true when a message with the business function code bfc released at t is accepted
+// using the standard Calendar
+func Allowed(bfc string, t time.Time) bool {
+	return defaultCalendar.Allowed(bfc, t)
+}
+
+// startOfDay returns midnight at the start of the date of t in Location
+func startOfDay(t time.Time) time.Time {
+	t = t.In(Location)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, Location)
+}
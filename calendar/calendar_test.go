@@ -0,0 +1,89 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, Location)
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	require.True(t, IsBusinessDay(date(2024, time.May, 8, 0, 0)))
+	require.False(t, IsBusinessDay(date(2024, time.May, 11, 0, 0)))
+	require.False(t, IsBusinessDay(date(2024, time.May, 12, 0, 0)))
+
+	require.False(t, IsBusinessDay(date(2023, time.July, 4, 0, 0)))
+	require.False(t, IsBusinessDay(date(2023, time.November, 23, 0, 0)))
+	require.True(t, IsBusinessDay(date(2023, time.November, 24, 0, 0)))
+
+	// holidays on a Sunday are observed on Monday
+	require.False(t, IsBusinessDay(date(2022, time.December, 26, 0, 0)))
+
+	// Federal Reserve Banks are open on the Friday before a holiday on a Saturday
+	require.True(t, IsBusinessDay(date(2021, time.December, 24, 0, 0)))
+	require.True(t, IsBusinessDay(date(2021, time.December, 31, 0, 0)))
+
+	// the date is taken in Location
+	require.False(t, IsBusinessDay(time.Date(2024, time.May, 13, 2, 0, 0, 0, time.UTC)))
+}
+
+func TestCycleDate(t *testing.T) {
+	require.Equal(t, date(2024, time.May, 8, 0, 0), CycleDate(date(2024, time.May, 8, 10, 0)))
+	require.Equal(t, date(2024, time.May, 9, 0, 0), CycleDate(date(2024, time.May, 8, 21, 30)))
+
+	// weekends
+	require.Equal(t, date(2024, time.May, 13, 0, 0), CycleDate(date(2024, time.May, 10, 20, 0)))
+	require.Equal(t, date(2024, time.May, 13, 0, 0), CycleDate(date(2024, time.May, 11, 12, 0)))
+	require.Equal(t, date(2024, time.May, 13, 0, 0), CycleDate(date(2024, time.May, 12, 22, 0)))
+
+	// Memorial Day
+	require.Equal(t, date(2024, time.May, 28, 0, 0), CycleDate(date(2024, time.May, 24, 21, 0)))
+}
+
+func TestIsOpen(t *testing.T) {
+	c := New()
+
+	require.True(t, c.IsOpen(date(2024, time.May, 8, 12, 0)))
+	require.False(t, c.IsOpen(date(2024, time.May, 8, 19, 30)))
+	require.True(t, c.IsOpen(date(2024, time.May, 8, 21, 0)))
+
+	require.False(t, c.IsOpen(date(2024, time.May, 11, 12, 0)))
+	require.False(t, c.IsOpen(date(2024, time.May, 12, 20, 59)))
+	require.True(t, c.IsOpen(date(2024, time.May, 12, 21, 0)))
+
+	// Memorial Day opens at 9:00 p.m. for Tuesday
+	require.False(t, c.IsOpen(date(2024, time.May, 26, 22, 0)))
+	require.True(t, c.IsOpen(date(2024, time.May, 27, 21, 0)))
+}
+
+func TestAllowed(t *testing.T) {
+	require.True(t, Allowed("CTR", date(2024, time.May, 8, 17, 59)))
+	require.False(t, Allowed("CTR", date(2024, time.May, 8, 18, 30)))
+	require.False(t, Allowed("CTP", date(2024, time.May, 8, 18, 30)))
+	require.True(t, Allowed("BTR", date(2024, time.May, 8, 18, 30)))
+	require.False(t, Allowed("BTR", date(2024, time.May, 8, 18, 50)))
+	require.True(t, Allowed("SVC", date(2024, time.May, 8, 18, 50)))
+	require.False(t, Allowed("SVC", date(2024, time.May, 8, 19, 0)))
+
+	// the next operating day
+	require.True(t, Allowed("CTR", date(2024, time.May, 12, 21, 30)))
+	require.False(t, Allowed("CTR", date(2024, time.May, 11, 12, 0)))
+}
+
+func TestCalendar_cutoffs(t *testing.T) {
+	c := New()
+	c.CustomerTransferCutoff = 16 * time.Hour
+
+	cycleDate := date(2024, time.May, 8, 0, 0)
+	require.Equal(t, date(2024, time.May, 8, 16, 0), c.Cutoff("CTR", cycleDate))
+	require.Equal(t, date(2024, time.May, 8, 18, 45), c.Cutoff("DRW", cycleDate))
+	require.False(t, c.Allowed("CTR", date(2024, time.May, 8, 16, 30)))
+}
@@ -138,31 +138,36 @@ func (c *Charges) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on Charges and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (c *Charges) Validate() error {
+	return c.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on Charges following opts, which may be nil
+func (c *Charges) validate(opts *ValidateOpts) error {
 	if err := c.fieldInclusion(); err != nil {
 		return err
 	}
 	if err := c.isChargeDetails(c.ChargeDetails); err != nil {
 		return fieldError("ChargeDetails", ErrChargeDetails, c.ChargeDetails)
 	}
-	if err := c.isAlphanumeric(c.SendersChargesOne); err != nil {
+	if err := c.isAlphanumeric(opts, c.SendersChargesOne); err != nil {
 		return fieldError("SendersChargesOne", err, c.SendersChargesOne)
 	}
 	/*	if err := c.validateCharges(c.SendersChargesOne); err != nil {
 		return fieldError("SendersChargesOne", err, c.SendersChargesOne)
 	}*/
-	if err := c.isAlphanumeric(c.SendersChargesTwo); err != nil {
+	if err := c.isAlphanumeric(opts, c.SendersChargesTwo); err != nil {
 		return fieldError("SendersChargesTwo", err, c.SendersChargesTwo)
 	}
 	/*	if err := c.validateCharges(c.SendersChargesTwo); err != nil {
 		return fieldError("SendersChargesTwo", err, c.SendersChargesTwo)
 	}*/
-	if err := c.isAlphanumeric(c.SendersChargesThree); err != nil {
+	if err := c.isAlphanumeric(opts, c.SendersChargesThree); err != nil {
 		return fieldError("SendersChargesThree", err, c.SendersChargesThree)
 	}
 	/*	if err := c.validateCharges(c.SendersChargesThree); err != nil {
 		return fieldError("SendersChargesThree", err, c.SendersChargesThree)
 	}*/
-	if err := c.isAlphanumeric(c.SendersChargesFour); err != nil {
+	if err := c.isAlphanumeric(opts, c.SendersChargesFour); err != nil {
 		return fieldError("SendersChargesFour", err, c.SendersChargesFour)
 	}
 	/*	if err := c.validateCharges(c.SendersChargesFour); err != nil {
@@ -221,3 +226,74 @@ func (c *Charges) FormatSendersChargesThree(options FormatOptions) string {
 func (c *Charges) FormatSendersChargesFour(options FormatOptions) string {
 	return c.formatAlphaField(c.SendersChargesFour, 15, options)
 }
+
+// SendersChargesOneMinorUnits returns the currency code of SendersChargesOne and its amount in the minor unit
+// of the currency, such as USD and 123456 for USD1234,56
+func (c *Charges) SendersChargesOneMinorUnits() (currencyCode string, minor int64, err error) {
+	return parseSendersCharges("SendersChargesOne", c.SendersChargesOne)
+}
+
+// SetSendersChargesOneMinorUnits sets SendersChargesOne from a currency code and a value in the minor unit of
+// the currency, such as USD and 123456 for USD1234,56
+func (c *Charges) SetSendersChargesOneMinorUnits(currencyCode string, minor int64) error {
+	return formatSendersCharges("SendersChargesOne", &c.SendersChargesOne, currencyCode, minor)
+}
+
+// SendersChargesTwoMinorUnits returns the currency code of SendersChargesTwo and its amount in the minor unit
+// of the currency, such as USD and 123456 for USD1234,56
+func (c *Charges) SendersChargesTwoMinorUnits() (currencyCode string, minor int64, err error) {
+	return parseSendersCharges("SendersChargesTwo", c.SendersChargesTwo)
+}
+
+// SetSendersChargesTwoMinorUnits sets SendersChargesTwo from a currency code and a value in the minor unit of
+// the currency, such as USD and 123456 for USD1234,56
+func (c *Charges) SetSendersChargesTwoMinorUnits(currencyCode string, minor int64) error {
+	return formatSendersCharges("SendersChargesTwo", &c.SendersChargesTwo, currencyCode, minor)
+}
+
+// SendersChargesThreeMinorUnits returns the currency code of SendersChargesThree and its amount in the minor unit
+// of the currency, such as USD and 123456 for USD1234,56
+func (c *Charges) SendersChargesThreeMinorUnits() (currencyCode string, minor int64, err error) {
+	return parseSendersCharges("SendersChargesThree", c.SendersChargesThree)
+}
+
+// SetSendersChargesThreeMinorUnits sets SendersChargesThree from a currency code and a value in the minor unit of
+// the currency, such as USD and 123456 for USD1234,56
+func (c *Charges) SetSendersChargesThreeMinorUnits(currencyCode string, minor int64) error {
+	return formatSendersCharges("SendersChargesThree", &c.SendersChargesThree, currencyCode, minor)
+}
+
+// SendersChargesFourMinorUnits returns the currency code of SendersChargesFour and its amount in the minor unit
+// of the currency, such as USD and 123456 for USD1234,56
+func (c *Charges) SendersChargesFourMinorUnits() (currencyCode string, minor int64, err error) {
+	return parseSendersCharges("SendersChargesFour", c.SendersChargesFour)
+}
+
+// SetSendersChargesFourMinorUnits sets SendersChargesFour from a currency code and a value in the minor unit of
+// the currency, such as USD and 123456 for USD1234,56
+func (c *Charges) SetSendersChargesFourMinorUnits(currencyCode string, minor int64) error {
+	return formatSendersCharges("SendersChargesFour", &c.SendersChargesFour, currencyCode, minor)
+}
+
+// parseSendersCharges parses value, the SendersCharges field named field, with ParseCurrencyAmount
+func parseSendersCharges(field, value string) (string, int64, error) {
+	currencyCode, minor, err := ParseCurrencyAmount(strings.TrimSpace(value))
+	if err != nil {
+		return "", 0, fieldError(field, err, value)
+	}
+	return currencyCode, minor, nil
+}
+
+// formatSendersCharges sets value, the SendersCharges field named field, with FormatCurrencyAmount. value is left
+// unchanged when the amount can't be formatted or is longer than the 15 characters of the field.
+func formatSendersCharges(field string, value *string, currencyCode string, minor int64) error {
+	charges, err := FormatCurrencyAmount(currencyCode, minor)
+	if err != nil {
+		return fieldError(field, err, minor)
+	}
+	if len(charges) > 15 {
+		return fieldError(field, ErrValidLength, charges)
+	}
+	*value = charges
+	return nil
+}
@@ -0,0 +1,18 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// Country struct for Country
+type Country struct {
+	// ISO 3166-1 alpha-2 code of the country
+	Code string `json:"code,omitempty"`
+	// English short name of the country
+	Name string `json:"name,omitempty"`
+}
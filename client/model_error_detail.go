@@ -0,0 +1,22 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ErrorDetail struct for ErrorDetail
+type ErrorDetail struct {
+	// Stable code of the error in the form WIRE-<tag>-<number>
+	Code string `json:"code,omitempty"`
+	// Tag number holding the field
+	Tag string `json:"tag,omitempty"`
+	// Path of the field within the FEDWireMessage
+	Field string `json:"field,omitempty"`
+	// An error message describing the problem intended for humans.
+	Message string `json:"message"`
+}
@@ -73,5 +73,7 @@ type FedWireMessage struct {
 	SecondaryRemittanceDocument     SecondaryRemittanceDocument     `json:"secondaryRemittanceDocument,omitempty"`
 	RemittanceFreeText              RemittanceFreeText              `json:"remittanceFreeText,omitempty"`
 	ServiceMessage                  ServiceMessage                  `json:"serviceMessage,omitempty"`
+	UnknownTags                     []UnknownTag                    `json:"unknownTags,omitempty"`
+	Spans                           map[string]Span                 `json:"spans,omitempty"`
 	ValidateOptions                 *ValidateOptions                `json:"validateOptions,omitempty"`
 }
@@ -0,0 +1,20 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// Span struct for Span
+type Span struct {
+	// Number of bytes preceding the tag in the file
+	Offset int64 `json:"offset,omitempty"`
+	// Number of bytes the tag covers, excluding any trailing newline
+	Length int32 `json:"length,omitempty"`
+	// The tag exactly as it appeared in the file
+	Raw string `json:"raw,omitempty"`
+}
@@ -0,0 +1,20 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// UnknownTag struct for UnknownTag
+type UnknownTag struct {
+	// Tag number
+	Tag string `json:"tag,omitempty"`
+	// Data following the tag number
+	Data string `json:"data,omitempty"`
+	// Tag which preceded this tag when it was read
+	After string `json:"after,omitempty"`
+}
@@ -15,4 +15,12 @@ type ValidateOptions struct {
 	SkipMandatoryIMAD bool `json:"skipMandatoryIMAD,omitempty"`
 	// Allow FedWireMessage.SenderSupplied to be nil
 	AllowMissingSenderSupplied bool `json:"allowMissingSenderSupplied,omitempty"`
+	// Check that tags were read in ascending order within their group
+	CheckTagOrder bool `json:"checkTagOrder,omitempty"`
+	// Check the country code of each SWIFT BIC is an ISO 3166-1 country
+	CheckBICCountry bool `json:"checkBICCountry,omitempty"`
+	// Turn off every validation
+	SkipAll bool `json:"skipAll,omitempty"`
+	// Names of validation rules to skip, such as Alphanumeric, CurrencyCode or a FEDWireMessage field name
+	SkipRules []string `json:"skipRules,omitempty"`
 }
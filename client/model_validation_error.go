@@ -0,0 +1,18 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ValidationError struct for ValidationError
+type ValidationError struct {
+	// An error message describing the problem intended for humans.
+	Error string `json:"error"`
+	// Each error found in the file
+	Errors []ErrorDetail `json:"errors,omitempty"`
+}
@@ -14,4 +14,6 @@ type WireFile struct {
 	// File ID
 	ID             string         `json:"ID,omitempty"`
 	FedWireMessage FedWireMessage `json:"fedWireMessage"`
+	// Messages following fedWireMessage in a multi-message file
+	AdditionalFedWireMessages []FedWireMessage `json:"additionalFedWireMessages,omitempty"`
 }
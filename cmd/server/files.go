@@ -36,6 +36,14 @@ var (
 
 	errNoFileId           = errors.New("no File ID found")
 	errNoFEDWireMessageID = errors.New("no FEDWireMessage ID found")
+
+	// uploadLimits bound the resources used reading untrusted files uploaded to /files/create
+	uploadLimits = wire.ReaderOpts{
+		MaxBytes:     20 * 1024 * 1024,
+		MaxTags:      1000000,
+		MaxTagLength: 10000,
+		MaxMessages:  10000,
+	}
 )
 
 func addFileRoutes(logger log.Logger, r *mux.Router, repo WireFileRepository) {
@@ -99,24 +107,35 @@ func createFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 
 		file := wire.NewFile()
 		if strings.Contains(r.Header.Get("Content-Type"), "application/json") {
-			if err := json.NewDecoder(r.Body).Decode(file); err != nil {
+			if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, uploadLimits.MaxBytes)).Decode(file); err != nil {
 				err = logger.LogErrorf("error reading request body: %v", err).Err()
 				moovhttp.Problem(w, err)
 				return
 			}
+			if len(file.Messages()) > uploadLimits.MaxMessages {
+				err := logger.LogErrorf("error reading request body: %v", wire.NewFileTooLongErr("MaxMessages", int64(uploadLimits.MaxMessages))).Err()
+				moovhttp.Problem(w, err)
+				return
+			}
 
 			if err := file.Validate(); err != nil {
-				err = logger.LogErrorf("file validation failed: %v", err).Err()
-				moovhttp.Problem(w, err)
+				validationProblem(w, logger.LogErrorf("file validation failed: %v", err).Err(), err)
 				return
 			}
 		} else {
-			f, err := wire.NewReader(r.Body).ReadWithOpts(validateOptsFromQuery(r.URL.Query()))
+			opts, err := validateOptsFromQuery(r.URL.Query())
 			if err != nil {
-				err = logger.LogErrorf("error reading file: %v", err).Err()
+				err = logger.LogErrorf("invalid validation options: %v", err).Err()
 				moovhttp.Problem(w, err)
 				return
 			}
+			reader := wire.NewReader(r.Body)
+			reader.SetOptions(&uploadLimits)
+			f, err := reader.ReadWithOpts(opts)
+			if err != nil {
+				validationProblem(w, logger.LogErrorf("error reading file: %v", err).Err(), err)
+				return
+			}
 			file = &f
 		}
 
@@ -245,6 +264,11 @@ func getFileContents(logger log.Logger, repo WireFileRepository) http.HandlerFun
 			moovhttp.Problem(w, err)
 			return
 		}
+		// reply in the format the file was uploaded in unless another was asked for
+		query := r.URL.Query()
+		if file.SourceFormatOptions != nil && query.Get("format") == "" && query.Get("newline") == "" {
+			writer = wire.NewWriter(w, wire.WithFormatOptions(*file.SourceFormatOptions))
+		}
 
 		w.Header().Set("Content-Type", "text/plain")
 		if err := writer.Write(file); err != nil {
@@ -285,8 +309,7 @@ func validateFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 		}
 
 		if err := file.Create(); err != nil { // Create calls Validate
-			err = logger.LogErrorf("file was invalid: %v", err).Err()
-			moovhttp.Problem(w, err)
+			validationProblem(w, logger.LogErrorf("file was invalid: %v", err).Err(), err)
 			return
 		}
 
@@ -337,7 +360,7 @@ func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository) http.Ha
 			return
 		}
 
-		file.FEDWireMessage = file.AddFEDWireMessage(req)
+		file.AddFEDWireMessage(req)
 		if err := repo.saveFile(file); err != nil {
 			err = logger.LogErrorf("error saving file: %v", err).Err()
 			moovhttp.Problem(w, err)
@@ -392,21 +415,47 @@ func GetWriter(w io.Writer, r *http.Request) (*wire.Writer, error) {
 	return writer, nil
 }
 
+// validationProblem writes err to w like moovhttp.Problem, adding the code, tag and field
+// of each error found in cause under "errors".
+func validationProblem(w http.ResponseWriter, err, cause error) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(struct {
+		Error  string             `json:"error"`
+		Errors []wire.ErrorDetail `json:"errors,omitempty"`
+	}{
+		Error:  err.Error(),
+		Errors: wire.ErrorDetails(cause),
+	})
+}
+
 // validateOptsFromQuery returns a ValidateOpts struct based on the query params.
 // If no validation query params were provided, opts will be nil.
-func validateOptsFromQuery(query url.Values) (opts *wire.ValidateOpts) {
+//
+// The profile param selects the starting options, such as wire.ProfileIncoming, which other params add to.
+// skipRules holds the names of rules to skip, separated by commas or given as repeated params.
+func validateOptsFromQuery(query url.Values) (opts *wire.ValidateOpts, err error) {
 	if len(query) == 0 {
-		return opts
+		return opts, nil
 	}
 
 	const (
 		skipMandatoryIMAD          = "skipMandatoryIMAD"
 		allowMissingSenderSupplied = "allowMissingSenderSupplied"
+		skipAll                    = "skipAll"
 	)
 
+	if profile := query.Get("profile"); profile != "" {
+		opts, err = wire.ValidateOptsForProfile(profile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	validationNames := []string{
 		skipMandatoryIMAD,
 		allowMissingSenderSupplied,
+		skipAll,
 	}
 
 	for _, param := range validationNames {
@@ -420,9 +469,22 @@ func validateOptsFromQuery(query url.Values) (opts *wire.ValidateOpts) {
 				opts.SkipMandatoryIMAD = true
 			case allowMissingSenderSupplied:
 				opts.AllowMissingSenderSupplied = true
+			case skipAll:
+				opts.SkipAll = true
+			}
+		}
+	}
+
+	for _, param := range query["skipRules"] {
+		for _, rule := range strings.Split(param, ",") {
+			if rule = strings.TrimSpace(rule); rule != "" {
+				if opts == nil {
+					opts = &wire.ValidateOpts{}
+				}
+				opts.SkipRules = append(opts.SkipRules, rule)
 			}
 		}
 	}
 
-	return opts
+	return opts, nil
 }
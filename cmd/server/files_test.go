@@ -15,6 +15,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -90,7 +91,7 @@ func TestFiles_createWithInterfaceData(t *testing.T) {
 	addFileRoutes(log.NewTestLogger(), router, repo)
 
 	w := httptest.NewRecorder()
-	raw := `FTI0811 XFT811  {1500}30        T {1510}1000{1520}20220128DOVTAL3C000001{2000}000000010000{3100}123456789DOVETAIL BANK US F*{3320}XX22012800000051*{3400}021000089CITIBANK NYC*{3600}CTP{3620}3*3AC4C307-0FFB-4028-BD8E-53D55BDB90E1*{3700}SUSD0,*{4200}D000100002*{5000}T000100011*DRESDEFFXXX*`
+	raw := `FTI0811 XFT811  {1500}30        T {1510}1000{1520}20220128DOVTAL3C000001{2000}000000010000{3100}121042882DOVETAIL BANK US F*{3320}XX22012800000051*{3400}021000089CITIBANK NYC*{3600}CTP{3620}3*3AC4C307-0FFB-4028-BD8E-53D55BDB90E1*{3700}SUSD0,*{4200}D000100002*{5000}T000100011*DRESDEFFXXX*`
 	req, err := http.NewRequest(http.MethodPost, "/files/create", bytes.NewReader([]byte(raw)))
 	require.NoError(t, err)
 
@@ -210,6 +211,59 @@ func TestFiles_createFileJSON(t *testing.T) {
 	})
 }
 
+func TestFiles_createFileLimits(t *testing.T) {
+	repo := &testWireFileRepository{}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo)
+
+	limits := uploadLimits
+	uploadLimits.MaxBytes = 1024
+	uploadLimits.MaxMessages = 1
+	t.Cleanup(func() { uploadLimits = limits })
+
+	t.Run("too many bytes", func(t *testing.T) {
+		bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+		require.NoError(t, err)
+		req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(bytes.Repeat(bs, 3)))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+		w.Flush()
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+		assert.Contains(t, w.Body.String(), "MaxBytes of 1024 exceeded")
+	})
+
+	t.Run("too many messages", func(t *testing.T) {
+		bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-MultipleMessages.txt"))
+		require.NoError(t, err)
+		uploadLimits.MaxBytes = int64(len(bs))
+		req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+		w.Flush()
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+		assert.Contains(t, w.Body.String(), "MaxMessages of 1 exceeded")
+	})
+
+	t.Run("JSON too large", func(t *testing.T) {
+		bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.json"))
+		require.NoError(t, err)
+		uploadLimits.MaxBytes = 1024
+		req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs))
+		req.Header.Set("content-type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+		w.Flush()
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+		assert.Contains(t, w.Body.String(), "request body too large")
+	})
+}
+
 func TestFiles_createFile_missingSenderSupplied(t *testing.T) {
 	repo := &testWireFileRepository{}
 	router := mux.NewRouter()
@@ -272,6 +326,73 @@ func TestFiles_createFile_missingSenderSupplied(t *testing.T) {
 	assert.NotNil(t, resp.Body)
 }
 
+func TestFiles_createFile_validationProfile(t *testing.T) {
+	repo := &testWireFileRepository{}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo)
+
+	bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	input := strings.Replace(string(bs), "{4200}31234*Name*", "{4200}31234*Zoë*", 1)
+
+	resp, _ := routerUploadRaw(t, router, strings.NewReader(input))
+	require.Equal(t, http.StatusBadRequest, resp.Code, resp.Body)
+
+	resp, uploaded := routerUploadRaw(t, router, strings.NewReader(input), setQueryParam("profile", wire.ProfileLenientImport))
+	require.Equal(t, http.StatusCreated, resp.Code, resp.Body)
+	require.Equal(t, "Zoë", uploaded.FEDWireMessage.Beneficiary.Personal.Name)
+
+	resp, uploaded = routerUploadRaw(t, router, strings.NewReader(input),
+		setQueryParam("profile", wire.ProfileIncoming),
+		setQueryParam("skipRules", wire.RuleAlphanumeric+","+wire.RuleCurrencyCode),
+	)
+	require.Equal(t, http.StatusCreated, resp.Code, resp.Body)
+	require.True(t, uploaded.FEDWireMessage.ValidateOptions.AllowMissingSenderSupplied)
+	require.Equal(t, []string{wire.RuleAlphanumeric, wire.RuleCurrencyCode}, uploaded.FEDWireMessage.ValidateOptions.SkipRules)
+
+	resp, _ = routerUploadRaw(t, router, strings.NewReader(input), setQueryParam("profile", "strict"))
+	require.Equal(t, http.StatusBadRequest, resp.Code, resp.Body)
+	require.Contains(t, resp.Body.String(), `unknown validation profile \"strict\"`)
+}
+
+func TestFiles_createFile_errorDetails(t *testing.T) {
+	repo := &testWireFileRepository{}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo)
+
+	bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	input := strings.Replace(string(bs), "{4200}31234*Name*", "{4200}31234*Zoë*", 1)
+
+	resp, _ := routerUploadRaw(t, router, strings.NewReader(input))
+	require.Equal(t, http.StatusBadRequest, resp.Code, resp.Body)
+
+	var problem struct {
+		Error  string             `json:"error"`
+		Errors []wire.ErrorDetail `json:"errors"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+	require.Contains(t, problem.Error, "has non alphanumeric characters")
+	require.Len(t, problem.Errors, 1)
+	require.Equal(t, "WIRE-0000-003", problem.Errors[0].Code)
+	require.Equal(t, "{4200}", problem.Errors[0].Tag)
+	require.Equal(t, "Beneficiary.Name", problem.Errors[0].Field)
+}
+
+func TestValidateOptsFromQuery(t *testing.T) {
+	opts, err := validateOptsFromQuery(url.Values{})
+	require.NoError(t, err)
+	require.Nil(t, opts)
+
+	opts, err = validateOptsFromQuery(url.Values{
+		"skipAll":   {"true"},
+		"skipRules": {"Alphanumeric, Charges", "CurrencyCode"},
+	})
+	require.NoError(t, err)
+	require.True(t, opts.SkipAll)
+	require.Equal(t, []string{"Alphanumeric", "Charges", "CurrencyCode"}, opts.SkipRules)
+}
+
 func setQueryParam(key, value string) func(values url.Values) url.Values {
 	return func(values url.Values) url.Values {
 		values.Set(key, value)
@@ -656,3 +777,58 @@ func TestFiles_addFEDWireMessageToFile(t *testing.T) {
 		t.Errorf("bogus HTTP status: %d: %v", w.Code, w.Body.String())
 	}
 }*/
+
+func TestFiles_getFileContentsInUploadedFormat(t *testing.T) {
+	repo := &testWireFileRepository{}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo)
+
+	// the fiserv file uses variable length fields without newlines
+	bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-fiserv.txt"))
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs)))
+	w.Flush()
+	require.Equal(t, http.StatusCreated, w.Code, w.Body)
+	require.NotNil(t, repo.file)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files/foo/contents", nil))
+	w.Flush()
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.NotContains(t, w.Body.String(), "\n")
+	require.Contains(t, w.Body.String(), "{3400}021000021JPMCHASE*{3600}CTR")
+
+	// query parameters still take precedence
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files/foo/contents?newline=true", nil))
+	w.Flush()
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Contains(t, w.Body.String(), "\n")
+}
+
+func TestFiles_createFile_crossTagErrorDetails(t *testing.T) {
+	repo := &testWireFileRepository{}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo)
+
+	bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	// the file parses, but a CustomerTransfer requires Beneficiary {4200}
+	input := regexp.MustCompile(`\{4200\}[^\n]*\n`).ReplaceAllString(string(bs), "")
+
+	resp, _ := routerUploadRaw(t, router, strings.NewReader(input))
+	require.Equal(t, http.StatusBadRequest, resp.Code, resp.Body)
+
+	var problem struct {
+		Error  string             `json:"error"`
+		Errors []wire.ErrorDetail `json:"errors"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+	require.Contains(t, problem.Error, "file validation failed")
+	require.Len(t, problem.Errors, 1)
+	require.Equal(t, "WIRE-0000-009", problem.Errors[0].Code)
+	require.Equal(t, "{4200}", problem.Errors[0].Tag)
+	require.Equal(t, "Beneficiary", problem.Errors[0].Field)
+}
@@ -7,6 +7,7 @@ package main
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"net/http"
@@ -83,6 +84,7 @@ func main() {
 	router := mux.NewRouter()
 	moovhttp.AddCORSHandler(router)
 	addPingRoute(router)
+	addCountriesRoute(router)
 	addFileRoutes(logger, router, repo)
 
 	// Start business HTTP server
@@ -147,3 +149,13 @@ func addPingRoute(r *mux.Router) {
 		w.Write([]byte("PONG"))
 	})
 }
+
+// addCountriesRoute lists the ISO 3166-1 countries accepted as country codes, so clients can offer the same list
+func addCountriesRoute(r *mux.Router) {
+	r.Methods("GET").Path("/countries").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		moovhttp.SetAccessControlAllowHeaders(w, r.Header.Get("Origin"))
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(wire.Countries())
+	})
+}
@@ -0,0 +1,31 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountries(t *testing.T) {
+	router := mux.NewRouter()
+	addCountriesRoute(router)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/countries", nil))
+	w.Flush()
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+
+	var countries []wire.Country
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&countries))
+	require.Equal(t, wire.Countries(), countries)
+	require.Contains(t, countries, wire.Country{Code: "US", Name: "United States of America"})
+}
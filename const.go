@@ -481,3 +481,76 @@ const (
 	// OptionFAdditionalInformation is Additional Information
 	OptionFAdditionalInformation = "8"
 )
+
+// tagFields maps each tag number to the FEDWireMessage field holding it
+var tagFields = func() map[string]string {
+	fields := make(map[string]string, len(fieldTags))
+	for field, tag := range fieldTags {
+		fields[tag] = field
+	}
+	return fields
+}()
+
+// fieldTags maps each FEDWireMessage field holding a tag to its tag number
+var fieldTags = map[string]string{
+	"MessageDisposition":              TagMessageDisposition,
+	"ReceiptTimeStamp":                TagReceiptTimeStamp,
+	"OutputMessageAccountabilityData": TagOutputMessageAccountabilityData,
+	"ErrorWire":                       TagErrorWire,
+	"SenderSupplied":                  TagSenderSupplied,
+	"TypeSubType":                     TagTypeSubType,
+	"InputMessageAccountabilityData":  TagInputMessageAccountabilityData,
+	"Amount":                          TagAmount,
+	"SenderDepositoryInstitution":     TagSenderDepositoryInstitution,
+	"ReceiverDepositoryInstitution":   TagReceiverDepositoryInstitution,
+	"BusinessFunctionCode":            TagBusinessFunctionCode,
+	"SenderReference":                 TagSenderReference,
+	"PreviousMessageIdentifier":       TagPreviousMessageIdentifier,
+	"LocalInstrument":                 TagLocalInstrument,
+	"PaymentNotification":             TagPaymentNotification,
+	"Charges":                         TagCharges,
+	"InstructedAmount":                TagInstructedAmount,
+	"ExchangeRate":                    TagExchangeRate,
+	"BeneficiaryIntermediaryFI":       TagBeneficiaryIntermediaryFI,
+	"BeneficiaryFI":                   TagBeneficiaryFI,
+	"Beneficiary":                     TagBeneficiary,
+	"BeneficiaryReference":            TagBeneficiaryReference,
+	"AccountDebitedDrawdown":          TagAccountDebitedDrawdown,
+	"Originator":                      TagOriginator,
+	"OriginatorOptionF":               TagOriginatorOptionF,
+	"OriginatorFI":                    TagOriginatorFI,
+	"InstructingFI":                   TagInstructingFI,
+	"AccountCreditedDrawdown":         TagAccountCreditedDrawdown,
+	"OriginatorToBeneficiary":         TagOriginatorToBeneficiary,
+	"FIReceiverFI":                    TagFIReceiverFI,
+	"FIDrawdownDebitAccountAdvice":    TagFIDrawdownDebitAccountAdvice,
+	"FIIntermediaryFI":                TagFIIntermediaryFI,
+	"FIIntermediaryFIAdvice":          TagFIIntermediaryFIAdvice,
+	"FIBeneficiaryFI":                 TagFIBeneficiaryFI,
+	"FIBeneficiaryFIAdvice":           TagFIBeneficiaryFIAdvice,
+	"FIBeneficiary":                   TagFIBeneficiary,
+	"FIBeneficiaryAdvice":             TagFIBeneficiaryAdvice,
+	"FIPaymentMethodToBeneficiary":    TagFIPaymentMethodToBeneficiary,
+	"FIAdditionalFIToFI":              TagFIAdditionalFIToFI,
+	"CurrencyInstructedAmount":        TagCurrencyInstructedAmount,
+	"OrderingCustomer":                TagOrderingCustomer,
+	"OrderingInstitution":             TagOrderingInstitution,
+	"IntermediaryInstitution":         TagIntermediaryInstitution,
+	"InstitutionAccount":              TagInstitutionAccount,
+	"BeneficiaryCustomer":             TagBeneficiaryCustomer,
+	"Remittance":                      TagRemittance,
+	"SenderToReceiver":                TagSenderToReceiver,
+	"UnstructuredAddenda":             TagUnstructuredAddenda,
+	"RelatedRemittance":               TagRelatedRemittance,
+	"RemittanceOriginator":            TagRemittanceOriginator,
+	"RemittanceBeneficiary":           TagRemittanceBeneficiary,
+	"PrimaryRemittanceDocument":       TagPrimaryRemittanceDocument,
+	"ActualAmountPaid":                TagActualAmountPaid,
+	"GrossAmountRemittanceDocument":   TagGrossAmountRemittanceDocument,
+	"AmountNegotiatedDiscount":        TagAmountNegotiatedDiscount,
+	"Adjustment":                      TagAdjustment,
+	"DateRemittanceDocument":          TagDateRemittanceDocument,
+	"SecondaryRemittanceDocument":     TagSecondaryRemittanceDocument,
+	"RemittanceFreeText":              TagRemittanceFreeText,
+	"ServiceMessage":                  TagServiceMessage,
+}
@@ -0,0 +1,26 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountries(t *testing.T) {
+	countries := Countries()
+	require.Len(t, countries, 249)
+	require.True(t, slices.IsSortedFunc(countries, func(a, b Country) int {
+		return strings.Compare(a.Code, b.Code)
+	}))
+	require.Len(t, countryCodes, len(countries))
+
+	// the table can't be changed through the returned slice
+	countries[0].Code = "ZZ"
+	require.Equal(t, "AD", Countries()[0].Code)
+}
@@ -103,10 +103,15 @@ func (cia *CurrencyInstructedAmount) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on CurrencyInstructedAmount and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (cia *CurrencyInstructedAmount) Validate() error {
+	return cia.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on CurrencyInstructedAmount following opts, which may be nil
+func (cia *CurrencyInstructedAmount) validate(opts *ValidateOpts) error {
 	if cia.tag != TagCurrencyInstructedAmount {
 		return fieldError("tag", ErrValidTagForType, cia.tag)
 	}
-	if err := cia.isAlphanumeric(cia.SwiftFieldTag); err != nil {
+	if err := cia.isAlphanumeric(opts, cia.SwiftFieldTag); err != nil {
 		return fieldError("SwiftFieldTag", err, cia.SwiftFieldTag)
 	}
 	if err := cia.isAmount(cia.Amount); err != nil {
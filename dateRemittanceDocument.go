@@ -7,6 +7,7 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"time"
 	"unicode/utf8"
 )
 
@@ -70,6 +71,11 @@ func (drd *DateRemittanceDocument) String() string {
 // Validate performs WIRE format rule checks on DateRemittanceDocument and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (drd *DateRemittanceDocument) Validate() error {
+	return drd.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on DateRemittanceDocument following opts, which may be nil
+func (drd *DateRemittanceDocument) validate(opts *ValidateOpts) error {
 	if err := drd.fieldInclusion(); err != nil {
 		return err
 	}
@@ -95,3 +101,17 @@ func (drd *DateRemittanceDocument) fieldInclusion() error {
 func (drd *DateRemittanceDocument) DateRemittanceDocumentField() string {
 	return drd.alphaField(drd.DateRemittanceDocument, 8)
 }
+
+// Date returns DateRemittanceDocument as the start of the day in FedwireLocation
+func (drd *DateRemittanceDocument) Date() (time.Time, error) {
+	t, err := parseCCYYMMDD(drd.DateRemittanceDocument)
+	if err != nil {
+		return time.Time{}, fieldError("DateRemittanceDocument", err, drd.DateRemittanceDocument)
+	}
+	return t, nil
+}
+
+// SetDate sets DateRemittanceDocument to the date of t in FedwireLocation
+func (drd *DateRemittanceDocument) SetDate(t time.Time) {
+	drd.DateRemittanceDocument = formatCCYYMMDD(t)
+}
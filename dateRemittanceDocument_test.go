@@ -67,3 +67,18 @@ func TestDateRemittanceDocumentTagError(t *testing.T) {
 
 	require.EqualError(t, err, fieldError("tag", ErrValidTagForType, drd.tag).Error())
 }
+
+// TestDateRemittanceDocumentDate validates reading and setting DateRemittanceDocument as a time.Time
+func TestDateRemittanceDocumentDate(t *testing.T) {
+	drd := mockDateRemittanceDocument()
+	drd.SetDate(time.Date(2019, time.February, 28, 23, 0, 0, 0, FedwireLocation))
+	require.Equal(t, "20190228", drd.DateRemittanceDocument)
+
+	date, err := drd.Date()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.February, 28, 0, 0, 0, 0, FedwireLocation), date)
+
+	drd.DateRemittanceDocument = "20190229"
+	_, err = drd.Date()
+	require.ErrorIs(t, err, ErrValidDate)
+}
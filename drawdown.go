@@ -0,0 +1,137 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// DrawdownResponseOpts contains options for RespondToDrawdownRequest
+type DrawdownResponseOpts struct {
+	// Refuse returns a non-value refusal of the request, with the RefusalRequestCredit SubTypeCode,
+	// in place of the DrawdownResponse funds transfer honoring it.
+	Refuse bool
+}
+
+// RespondToDrawdownRequest returns the message answering a drawdown request received in a BankDrawDownRequest (DRB)
+// or CustomerCorporateDrawdownRequest (DRC). The response is sent by the receiver of the request, so the sender and
+// receiver DIs are swapped, and {3500} PreviousMessageIdentifier is the IMAD of the request.
+//
+// By default the response is a DrawdownResponse (DRW) funds transfer of the requested Amount with the
+// FundsTransferRequestCredit SubTypeCode. {4400} AccountDebitedDrawdown of the request becomes the {5000} Originator
+// and the funds are sent to the DI of {5400} AccountCreditedDrawdown. The Beneficiary, BeneficiaryFI,
+// BeneficiaryIntermediaryFI and BeneficiaryReference of the request are kept. A DRB without a Beneficiary is
+// paid to the DI of {5400} AccountCreditedDrawdown, named after the sender or receiver DI of the request with the
+// same ABA number. The name is left empty when neither DI matches.
+//
+// When opts.Refuse is set the response is a refusal keeping the business function code and tags of the request,
+// with the RefusalRequestCredit SubTypeCode.
+//
+// The {1520} InputMessageAccountabilityData is not set, and must be added before the response is sent,
+// such as from an IMADGenerator.
+func RespondToDrawdownRequest(req *FEDWireMessage, opts DrawdownResponseOpts) (*FEDWireMessage, error) {
+	if err := checkDrawdownRequest(req); err != nil {
+		return nil, err
+	}
+
+	c := req.clone()
+	sdi, rdi := swapDepositoryInstitutions(req)
+	if opts.Refuse {
+		c.ID = ""
+		c.MessageDisposition = nil
+		c.ReceiptTimeStamp = nil
+		c.OutputMessageAccountabilityData = nil
+		c.ErrorWire = nil
+		c.SenderSupplied = responseSenderSupplied(req)
+		c.TypeSubType.SubTypeCode = RefusalRequestCredit
+		c.InputMessageAccountabilityData = nil
+		c.SenderDepositoryInstitution, c.ReceiverDepositoryInstitution = sdi, rdi
+		c.PreviousMessageIdentifier = newPreviousMessageIdentifier(req.InputMessageAccountabilityData)
+		c.UnknownTags = nil
+		c.Spans = nil
+		c.SourceFormat = nil
+		return c, nil
+	}
+
+	c.TypeSubType.SubTypeCode = FundsTransferRequestCredit
+	bfc := NewBusinessFunctionCode()
+	bfc.BusinessFunctionCode = DrawdownResponse
+	fwm := &FEDWireMessage{
+		SenderSupplied:                responseSenderSupplied(req),
+		TypeSubType:                   c.TypeSubType,
+		Amount:                        c.Amount,
+		SenderDepositoryInstitution:   sdi,
+		ReceiverDepositoryInstitution: rdi,
+		BusinessFunctionCode:          bfc,
+		PreviousMessageIdentifier:     newPreviousMessageIdentifier(req.InputMessageAccountabilityData),
+		BeneficiaryIntermediaryFI:     c.BeneficiaryIntermediaryFI,
+		BeneficiaryFI:                 c.BeneficiaryFI,
+		Beneficiary:                   c.Beneficiary,
+		BeneficiaryReference:          c.BeneficiaryReference,
+		ValidateOptions:               c.ValidateOptions,
+	}
+
+	// the funds are credited to the DI of {5400}, which is usually the sender of the request
+	credited := c.AccountCreditedDrawdown.DrawdownCreditAccountNumber
+	if rdi == nil || rdi.ReceiverABANumber != credited {
+		fwm.ReceiverDepositoryInstitution = NewReceiverDepositoryInstitution()
+		fwm.ReceiverDepositoryInstitution.ReceiverABANumber = credited
+		fwm.ReceiverDepositoryInstitution.ReceiverShortName = depositoryInstitutionShortName(req, credited)
+	}
+	if fwm.Beneficiary == nil {
+		fwm.Beneficiary = NewBeneficiary()
+		fwm.Beneficiary.Personal.IdentificationCode = FEDRoutingNumber
+		fwm.Beneficiary.Personal.Identifier = credited
+		fwm.Beneficiary.Personal.Name = fwm.ReceiverDepositoryInstitution.ReceiverShortName
+	}
+
+	debited := c.AccountDebitedDrawdown
+	fwm.Originator = NewOriginator()
+	fwm.Originator.Personal = Personal{
+		IdentificationCode: debited.IdentificationCode,
+		Identifier:         debited.Identifier,
+		Name:               debited.Name,
+		Address:            debited.Address,
+	}
+	return fwm, nil
+}
+
+// depositoryInstitutionShortName returns the short name of the sender or receiver DI of req with the ABA number
+// abaNumber. It returns an empty string when neither DI has abaNumber, as the name of the DI isn't known.
+func depositoryInstitutionShortName(req *FEDWireMessage, abaNumber string) string {
+	if sdi := req.SenderDepositoryInstitution; sdi != nil && sdi.SenderABANumber == abaNumber {
+		return sdi.SenderShortName
+	}
+	if rdi := req.ReceiverDepositoryInstitution; rdi != nil && rdi.ReceiverABANumber == abaNumber {
+		return rdi.ReceiverShortName
+	}
+	return ""
+}
+
+// checkDrawdownRequest returns an error when req isn't a drawdown request which can be answered
+func checkDrawdownRequest(req *FEDWireMessage) error {
+	switch {
+	case req.TypeSubType == nil:
+		return fieldError("TypeSubType", ErrFieldRequired)
+	case req.InputMessageAccountabilityData == nil:
+		return fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	case req.BusinessFunctionCode == nil:
+		return fieldError("BusinessFunctionCode", ErrFieldRequired)
+	}
+
+	var missing []error
+	switch bfc := req.BusinessFunctionCode.BusinessFunctionCode; bfc {
+	case BankDrawDownRequest:
+		missing = req.missingBankDrawdownRequestTags()
+	case CustomerCorporateDrawdownRequest:
+		missing = req.missingCustomerCorporateDrawdownRequestTags()
+	default:
+		return fieldError("BusinessFunctionCode", ErrBusinessFunctionCode, bfc)
+	}
+	if len(missing) > 0 {
+		return missing[0]
+	}
+
+	if req.TypeSubType.SubTypeCode != RequestCredit {
+		return fieldError("SubTypeCode", ErrSubTypeCode, req.TypeSubType.SubTypeCode)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockCustomerCorporateDrawdownRequest creates a CustomerCorporateDrawdownRequest crediting its sender
+func mockCustomerCorporateDrawdownRequest() *FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.TypeSubType.SubTypeCode = RequestCredit
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerCorporateDrawdownRequest
+	fwm.BusinessFunctionCode.TransactionTypeCode = ""
+	fwm.BeneficiaryFI = mockBeneficiaryFI()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.AccountDebitedDrawdown = mockAccountDebitedDrawdown()
+	fwm.AccountCreditedDrawdown = mockAccountCreditedDrawdown()
+	fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber = fwm.SenderDepositoryInstitution.SenderABANumber
+	return &fwm
+}
+
+func TestRespondToDrawdownRequest(t *testing.T) {
+	req := mockCustomerCorporateDrawdownRequest()
+	require.NoError(t, req.ValidateAll())
+
+	fwm, err := RespondToDrawdownRequest(req, DrawdownResponseOpts{})
+	require.NoError(t, err)
+	require.Equal(t, DrawdownResponse, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, FundsTransfer+FundsTransferRequestCredit, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, req.Amount.Amount, fwm.Amount.Amount)
+	require.Equal(t, req.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, req.SenderDepositoryInstitution.SenderABANumber, fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+	require.Equal(t, req.SenderDepositoryInstitution.SenderShortName, fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+	require.Equal(t, req.Beneficiary.Personal, fwm.Beneficiary.Personal)
+	require.Equal(t, req.AccountDebitedDrawdown.Identifier, fwm.Originator.Personal.Identifier)
+	require.Equal(t, req.AccountDebitedDrawdown.Name, fwm.Originator.Personal.Name)
+	require.Nil(t, fwm.AccountDebitedDrawdown)
+	require.Nil(t, fwm.AccountCreditedDrawdown)
+
+	fwm.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	require.NoError(t, fwm.validateDrawdownResponse())
+	require.NoError(t, fwm.ValidateAll())
+}
+
+func TestRespondToDrawdownRequest_bankDrawdown(t *testing.T) {
+	req := mockCustomerCorporateDrawdownRequest()
+	req.TypeSubType.TypeCode = SettlementTransfer
+	req.BusinessFunctionCode.BusinessFunctionCode = BankDrawDownRequest
+	req.BeneficiaryFI = nil
+	req.Beneficiary = nil
+	req.AccountCreditedDrawdown.DrawdownCreditAccountNumber = "231380104"
+	require.NoError(t, req.ValidateAll())
+
+	fwm, err := RespondToDrawdownRequest(req, DrawdownResponseOpts{})
+	require.NoError(t, err)
+	require.Equal(t, SettlementTransfer+FundsTransferRequestCredit, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+
+	// the funds are sent to the DI of AccountCreditedDrawdown, which is paid as the Beneficiary
+	require.Equal(t, "231380104", fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+	require.Equal(t, FEDRoutingNumber, fwm.Beneficiary.Personal.IdentificationCode)
+	require.Equal(t, "231380104", fwm.Beneficiary.Personal.Identifier)
+
+	// the DI is named after the DI of the request with the same ABA number
+	require.Equal(t, "231380104", req.ReceiverDepositoryInstitution.ReceiverABANumber)
+	require.Equal(t, req.ReceiverDepositoryInstitution.ReceiverShortName, fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+	require.Equal(t, req.ReceiverDepositoryInstitution.ReceiverShortName, fwm.Beneficiary.Personal.Name)
+	require.NotEmpty(t, fwm.Beneficiary.Personal.Name)
+
+	fwm.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	require.NoError(t, fwm.ValidateAll())
+
+	// the name is left empty when no DI of the request has the ABA number
+	req.AccountCreditedDrawdown.DrawdownCreditAccountNumber = "021000021"
+	fwm, err = RespondToDrawdownRequest(req, DrawdownResponseOpts{})
+	require.NoError(t, err)
+	require.Equal(t, "021000021", fwm.Beneficiary.Personal.Identifier)
+	require.Empty(t, fwm.Beneficiary.Personal.Name)
+}
+
+func TestRespondToDrawdownRequest_refuse(t *testing.T) {
+	req := mockCustomerCorporateDrawdownRequest()
+	imad := req.InputMessageAccountabilityData
+
+	fwm, err := RespondToDrawdownRequest(req, DrawdownResponseOpts{Refuse: true})
+	require.NoError(t, err)
+	require.Equal(t, CustomerCorporateDrawdownRequest, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, RefusalRequestCredit, fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, req.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, imad.InputCycleDate+imad.InputSource+imad.InputSequenceNumber, fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, req.AccountDebitedDrawdown, fwm.AccountDebitedDrawdown)
+	require.Nil(t, fwm.InputMessageAccountabilityData)
+
+	// req is not changed
+	require.Equal(t, RequestCredit, req.TypeSubType.SubTypeCode)
+
+	fwm.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	require.NoError(t, fwm.ValidateAll())
+}
+
+func TestRespondToDrawdownRequest_invalid(t *testing.T) {
+	req := mockCustomerCorporateDrawdownRequest()
+	req.AccountDebitedDrawdown = nil
+	_, err := RespondToDrawdownRequest(req, DrawdownResponseOpts{})
+	require.EqualError(t, err, fieldError("AccountDebitedDrawdown", ErrFieldRequired).Error())
+
+	req = mockCustomerCorporateDrawdownRequest()
+	req.TypeSubType.SubTypeCode = RefusalRequestCredit
+	_, err = RespondToDrawdownRequest(req, DrawdownResponseOpts{})
+	require.ErrorIs(t, err, ErrSubTypeCode)
+
+	_, err = RespondToDrawdownRequest(mockReversibleTransfer(), DrawdownResponseOpts{})
+	require.ErrorIs(t, err, ErrBusinessFunctionCode)
+}
@@ -0,0 +1,162 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/moov-io/base"
+)
+
+// errorCodes holds the stable code of each sentinel error. Codes are in the form WIRE-<tag>-<number>, where
+// <tag> is the number of the tag the error is specific to, 0000 for errors found in any tag, or FILE for
+// errors found while reading a file. Codes must not be changed or reused once released.
+var errorCodes = map[error]string{
+	ErrValidTagForType:  "WIRE-0000-001",
+	ErrNonNumeric:       "WIRE-0000-002",
+	ErrNonAlphanumeric:  "WIRE-0000-003",
+	ErrNonAmount:        "WIRE-0000-004",
+	ErrNonCurrencyCode:  "WIRE-0000-005",
+	ErrUpperAlpha:       "WIRE-0000-006",
+	ErrFieldInclusion:   "WIRE-0000-007",
+	ErrConstructor:      "WIRE-0000-008",
+	ErrFieldRequired:    "WIRE-0000-009",
+	ErrNotPermitted:     "WIRE-0000-010",
+	ErrValidMonth:       "WIRE-0000-011",
+	ErrValidDay:         "WIRE-0000-012",
+	ErrValidYear:        "WIRE-0000-013",
+	ErrValidCentury:     "WIRE-0000-014",
+	ErrValidDate:        "WIRE-0000-015",
+	ErrInvalidProperty:  "WIRE-0000-016",
+	ErrValidLength:      "WIRE-0000-017",
+	ErrRequireDelimiter: "WIRE-0000-018",
+	ErrABANumber:        "WIRE-0000-021",
+	ErrBIC:              "WIRE-0000-022",
+	ErrIBAN:             "WIRE-0000-023",
+	ErrNonCountryCode:   "WIRE-0000-024",
+
+	ErrFormatVersion:          "WIRE-1500-001",
+	ErrTestProductionCode:     "WIRE-1500-002",
+	ErrMessageDuplicationCode: "WIRE-1500-003",
+
+	ErrTypeCode:    "WIRE-1510-001",
+	ErrSubTypeCode: "WIRE-1510-002",
+
+	ErrIMADCycleDate:         "WIRE-1520-001",
+	ErrIMADSequenceExhausted: "WIRE-1520-002",
+
+	ErrBusinessFunctionCode: "WIRE-3600-001",
+	ErrTransactionTypeCode:  "WIRE-3600-002",
+
+	ErrLocalInstrumentNotPermitted: "WIRE-3610-001",
+	ErrLocalInstrumentCode:         "WIRE-3610-002",
+
+	ErrPaymentNotificationIndicator: "WIRE-3620-001",
+
+	ErrChargeDetails: "WIRE-3700-001",
+
+	ErrIdentificationCode: "WIRE-4000-001",
+	ErrAdviceCode:         "WIRE-4000-002",
+
+	ErrRemittanceLocationMethod:       "WIRE-8250-001",
+	ErrAddressType:                    "WIRE-8250-002",
+	ErrIdentificationType:             "WIRE-8250-003",
+	ErrOrganizationIdentificationCode: "WIRE-8250-004",
+	ErrPrivateIdentificationCode:      "WIRE-8250-005",
+	ErrDocumentTypeCode:               "WIRE-8250-006",
+	ErrCreditDebitIndicator:           "WIRE-8250-007",
+	ErrAdjustmentReasonCode:           "WIRE-8250-008",
+
+	ErrPartyIdentifier: "WIRE-5010-001",
+	ErrOptionFLine:     "WIRE-5010-002",
+	ErrOptionFName:     "WIRE-5010-003",
+
+	ErrFileTooLong:     "WIRE-FILE-001",
+	ErrFileTooLarge:    "WIRE-FILE-007",
+	ErrTagOrderUnknown: "WIRE-FILE-009",
+}
+
+// ErrorCode returns the stable code of err, such as WIRE-3600-002 for ErrTransactionTypeCode. Wrapped
+// errors are searched for a code, so the code of a *FieldError or base.ParseError is the code of the
+// error it holds. An empty string is returned when err has no code, such as errors from a ValidationRule.
+func ErrorCode(err error) string {
+	for err != nil {
+		if coded, ok := err.(interface{ Code() string }); ok {
+			return coded.Code()
+		}
+		if reflect.TypeOf(err).Comparable() {
+			if code, ok := errorCodes[err]; ok {
+				return code
+			}
+		}
+		err = errors.Unwrap(err)
+	}
+	return ""
+}
+
+// ErrorDetail describes an error found in a File or FEDWireMessage
+type ErrorDetail struct {
+	Code    string `json:"code,omitempty"`  // stable code of the error, such as WIRE-3600-002
+	Tag     string `json:"tag,omitempty"`   // tag number holding the field, such as {3600}
+	Field   string `json:"field,omitempty"` // path of the field within the FEDWireMessage, such as BusinessFunctionCode.TransactionTypeCode
+	Message string `json:"message"`
+}
+
+// ErrorDetails returns an ErrorDetail for each error within err, which may be a base.ErrorList
+// such as the errors returned by Reader.Read or FEDWireMessage.ValidateAll.
+func ErrorDetails(err error) []ErrorDetail {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case base.ErrorList:
+		var details []ErrorDetail
+		for _, err := range e {
+			details = append(details, ErrorDetails(err)...)
+		}
+		return details
+	case *MessageError:
+		details := ErrorDetails(e.Err)
+		for i := range details {
+			details[i].Message = fmt.Sprintf("message %d: %s", e.Index+1, details[i].Message)
+		}
+		return details
+	}
+	return []ErrorDetail{newErrorDetail(err)}
+}
+
+// newErrorDetail returns the ErrorDetail of err, finding the tag and field from the
+// *FieldError or base.ParseError it holds
+func newErrorDetail(err error) ErrorDetail {
+	detail := ErrorDetail{Code: ErrorCode(err), Message: err.Error()}
+
+	// the Record of a ParseError is the FEDWireMessage field being read, or the tag number when it isn't known
+	var record string
+	var pe *base.ParseError
+	if errors.As(err, &pe) {
+		record = pe.Record
+		if field, ok := tagFields[record]; ok {
+			detail.Tag, record = record, field
+		}
+	}
+
+	detail.Field = record
+	var fe *FieldError
+	if errors.As(err, &fe) {
+		switch {
+		case fe.Path != "":
+			detail.Tag, detail.Field = fe.Tag, fe.Path
+		case record == "" || fieldTags[pathRoot(fe.FieldName)] != "":
+			detail.Field = fe.FieldName
+		case fe.FieldName != record:
+			detail.Field = record + "." + fe.FieldName
+		}
+	}
+	if detail.Tag == "" {
+		detail.Tag = fieldTags[pathRoot(detail.Field)]
+	}
+	return detail
+}
@@ -0,0 +1,95 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorCode(t *testing.T) {
+	require.Equal(t, "WIRE-3600-002", ErrorCode(ErrTransactionTypeCode))
+	require.Equal(t, "WIRE-0000-009", ErrorCode(fieldError("Beneficiary", ErrFieldRequired)))
+	require.Equal(t, "WIRE-0000-009", (&FieldError{Err: ErrFieldRequired}).Code())
+	require.Equal(t, "WIRE-0000-003", ErrorCode(&base.ParseError{Err: fieldError("Name", ErrNonAlphanumeric)}))
+	require.Equal(t, "WIRE-0000-003", ErrorCode(fmt.Errorf("wrapped: %w", ErrNonAlphanumeric)))
+	require.Equal(t, "WIRE-3600-003", ErrorCode(NewErrBusinessFunctionCodeProperty("LocalInstrument", "ANSI", "CTR")))
+	require.Equal(t, "WIRE-FILE-001", ErrorCode(NewFileTooLongErr("MaxTags", 10)))
+	require.Equal(t, "WIRE-FILE-007", ErrorCode(NewFileTooLongErr("MaxBytes", 10)))
+	require.Equal(t, "WIRE-FILE-008", ErrorCode(NewTagTooLongErr(10, 11)))
+	require.Equal(t, "WIRE-FILE-003", ErrorCode(&MessageError{Err: NewErrInvalidTag("{9999}")}))
+
+	require.Empty(t, ErrorCode(nil))
+	require.Empty(t, ErrorCode(errors.New("is a required field")))
+	require.Empty(t, ErrorCode(base.ErrorList{ErrFieldRequired}))
+}
+
+func TestErrorCode_unique(t *testing.T) {
+	format := regexp.MustCompile(`^WIRE-(\d{4}|FILE)-\d{3}$`)
+	seen := make(map[string]error)
+	for err, code := range errorCodes {
+		require.Regexp(t, format, code)
+		require.NotContains(t, seen, code, "%s is used by %v and %v", code, seen[code], err)
+		seen[code] = err
+	}
+	typed := []error{
+		ErrBusinessFunctionCodeProperty{}, ErrInvalidPropertyForProperty{}, FieldWrongLengthErr{},
+		TagWrongLengthErr{}, TagTooLongErr{}, ErrInvalidTag{}, ErrDuplicateTag{}, ErrTagOrder{},
+	}
+	for _, err := range typed {
+		code := ErrorCode(err)
+		require.Regexp(t, format, code)
+		require.NotContains(t, seen, code, "%s is used by %v and %T", code, seen[code], err)
+		seen[code] = err
+	}
+}
+
+func TestErrorDetails(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	input := strings.Replace(string(bs), "{4200}31234*Name*", "{4200}31234*Zoë*", 1)
+
+	_, err = NewReader(strings.NewReader(input)).Read()
+	details := ErrorDetails(err)
+	require.Len(t, details, 1)
+	require.Equal(t, "WIRE-0000-003", details[0].Code)
+	require.Equal(t, TagBeneficiary, details[0].Tag)
+	require.Equal(t, "Beneficiary.Name", details[0].Field)
+	require.Contains(t, details[0].Message, "has non alphanumeric characters")
+
+	fwm := mockCustomerTransferData()
+	fwm.BusinessFunctionCode.TransactionTypeCode = "ZZZ"
+	details = ErrorDetails(fwm.ValidateAll())
+	require.Contains(t, details, ErrorDetail{
+		Code:    "WIRE-3600-002",
+		Tag:     TagBusinessFunctionCode,
+		Field:   "BusinessFunctionCode.TransactionTypeCode",
+		Message: "{3600} BusinessFunctionCode.TransactionTypeCode ZZZ is an invalid transaction type code",
+	})
+	require.Contains(t, details, ErrorDetail{
+		Code:    "WIRE-0000-009",
+		Tag:     TagBeneficiary,
+		Field:   "Beneficiary",
+		Message: "{4200} Beneficiary is a required field",
+	})
+
+	details = ErrorDetails(&MessageError{Index: 1, Err: fieldError("Originator", ErrFieldRequired)})
+	require.Equal(t, []ErrorDetail{{
+		Code:    "WIRE-0000-009",
+		Tag:     TagOriginator,
+		Field:   "Originator",
+		Message: "message 2: Originator is a required field",
+	}}, details)
+
+	require.Nil(t, ErrorDetails(nil))
+}
@@ -116,6 +116,11 @@ func (ew *ErrorWire) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on ErrorWire and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (ew *ErrorWire) Validate() error {
+	return ew.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on ErrorWire following opts, which may be nil
+func (ew *ErrorWire) validate(opts *ValidateOpts) error {
 	// Currently no validation as the FED is responsible for the values
 	return nil
 }
@@ -6,6 +6,7 @@ package wire
 
 import (
 	"encoding/json"
+	"math/big"
 	"strings"
 	"unicode/utf8"
 )
@@ -97,6 +98,11 @@ func (eRate *ExchangeRate) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on ExchangeRate and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (eRate *ExchangeRate) Validate() error {
+	return eRate.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on ExchangeRate following opts, which may be nil
+func (eRate *ExchangeRate) validate(opts *ValidateOpts) error {
 	if eRate.tag != TagExchangeRate {
 		return fieldError("tag", ErrValidTagForType, eRate.tag)
 	}
@@ -115,3 +121,31 @@ func (eRate *ExchangeRate) ExchangeRateField() string {
 func (eRate *ExchangeRate) FormatExchangeRate(options FormatOptions) string {
 	return eRate.formatAlphaField(eRate.ExchangeRate, 12, options)
 }
+
+// Rate returns ExchangeRate as an exact rational number, such as 12345/10000 for 1,2345
+func (eRate *ExchangeRate) Rate() (*big.Rat, error) {
+	rate := strings.TrimSpace(eRate.ExchangeRate)
+	_, fraction, _ := strings.Cut(rate, ",")
+	value, err := parseMinorUnits(rate, ',', len(fraction))
+	if err != nil {
+		return nil, fieldError("ExchangeRate", err, eRate.ExchangeRate)
+	}
+	denominator := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(fraction))), nil)
+	return new(big.Rat).SetFrac(big.NewInt(value), denominator), nil
+}
+
+// SetRate sets ExchangeRate to rate rounded to decimals places, such as 1,2345 for 12345/10000 and 4 decimals
+func (eRate *ExchangeRate) SetRate(rate *big.Rat, decimals int) error {
+	if rate == nil {
+		return fieldError("ExchangeRate", ErrFieldRequired)
+	}
+	if rate.Sign() < 0 {
+		return fieldError("ExchangeRate", ErrNonAmount, rate.FloatString(decimals))
+	}
+	exchangeRate := strings.Replace(rate.FloatString(decimals), ".", ",", 1)
+	if len(exchangeRate) > 12 {
+		return fieldError("ExchangeRate", ErrValidLength, exchangeRate)
+	}
+	eRate.ExchangeRate = exchangeRate
+	return nil
+}
@@ -2,6 +2,7 @@ package wire
 
 import (
 	"errors"
+	"math/big"
 	"strings"
 	"testing"
 
@@ -117,3 +118,31 @@ func TestStringExchangeRateOptions(t *testing.T) {
 	require.Equal(t, "{3720}123*", record.Format(FormatOptions{VariableLengthFields: true}))
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
+
+// TestExchangeRateRate validates reading and setting ExchangeRate as a rational number
+func TestExchangeRateRate(t *testing.T) {
+	eRate := mockExchangeRate()
+	eRate.ExchangeRate = "1,2345"
+	rate, err := eRate.Rate()
+	require.NoError(t, err)
+	require.Equal(t, big.NewRat(12345, 10000), rate)
+
+	eRate.ExchangeRate = "15"
+	rate, err = eRate.Rate()
+	require.NoError(t, err)
+	require.Equal(t, big.NewRat(15, 1), rate)
+
+	require.NoError(t, eRate.SetRate(big.NewRat(1, 3), 6))
+	require.Equal(t, "0,333333", eRate.ExchangeRate)
+	require.NoError(t, eRate.Validate())
+
+	require.ErrorIs(t, eRate.SetRate(big.NewRat(-1, 3), 6), ErrNonAmount)
+	require.ErrorIs(t, eRate.SetRate(nil, 6), ErrFieldRequired)
+	require.ErrorIs(t, eRate.SetRate(big.NewRat(1, 3), 11), ErrValidLength)
+	require.ErrorIs(t, eRate.SetRate(big.NewRat(1234567890123, 1), 0), ErrValidLength)
+	require.Equal(t, "0,333333", eRate.ExchangeRate)
+
+	eRate.ExchangeRate = "1.2345"
+	_, err = eRate.Rate()
+	require.ErrorIs(t, err, ErrNonAmount)
+}
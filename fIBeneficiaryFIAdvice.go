@@ -138,28 +138,33 @@ func (fibfia *FIBeneficiaryFIAdvice) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on FIBeneficiaryFIAdvice and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (fibfia *FIBeneficiaryFIAdvice) Validate() error {
+	return fibfia.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on FIBeneficiaryFIAdvice following opts, which may be nil
+func (fibfia *FIBeneficiaryFIAdvice) validate(opts *ValidateOpts) error {
 	if fibfia.tag != TagFIBeneficiaryFIAdvice {
 		return fieldError("tag", ErrValidTagForType, fibfia.tag)
 	}
 	if err := fibfia.isAdviceCode(fibfia.Advice.AdviceCode); err != nil {
 		return fieldError("AdviceCode", err, fibfia.Advice.AdviceCode)
 	}
-	if err := fibfia.isAlphanumeric(fibfia.Advice.LineOne); err != nil {
+	if err := fibfia.isAlphanumeric(opts, fibfia.Advice.LineOne); err != nil {
 		return fieldError("LineOne", err, fibfia.Advice.LineOne)
 	}
-	if err := fibfia.isAlphanumeric(fibfia.Advice.LineTwo); err != nil {
+	if err := fibfia.isAlphanumeric(opts, fibfia.Advice.LineTwo); err != nil {
 		return fieldError("LineTwo", err, fibfia.Advice.LineTwo)
 	}
-	if err := fibfia.isAlphanumeric(fibfia.Advice.LineThree); err != nil {
+	if err := fibfia.isAlphanumeric(opts, fibfia.Advice.LineThree); err != nil {
 		return fieldError("LineThree", err, fibfia.Advice.LineThree)
 	}
-	if err := fibfia.isAlphanumeric(fibfia.Advice.LineFour); err != nil {
+	if err := fibfia.isAlphanumeric(opts, fibfia.Advice.LineFour); err != nil {
 		return fieldError("LineFour", err, fibfia.Advice.LineFour)
 	}
-	if err := fibfia.isAlphanumeric(fibfia.Advice.LineFive); err != nil {
+	if err := fibfia.isAlphanumeric(opts, fibfia.Advice.LineFive); err != nil {
 		return fieldError("LineFive", err, fibfia.Advice.LineFive)
 	}
-	if err := fibfia.isAlphanumeric(fibfia.Advice.LineSix); err != nil {
+	if err := fibfia.isAlphanumeric(opts, fibfia.Advice.LineSix); err != nil {
 		return fieldError("LineSix", err, fibfia.Advice.LineSix)
 	}
 	return nil
@@ -4,7 +4,11 @@
 
 package wire
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/moov-io/base"
+)
 
 // FEDWireMessage is a FedWire Message
 type FEDWireMessage struct {
@@ -131,8 +135,23 @@ type FEDWireMessage struct {
 	RemittanceFreeText *RemittanceFreeText `json:"remittanceFreeText,omitempty"`
 	// ServiceMessage
 	ServiceMessage *ServiceMessage `json:"serviceMessage,omitempty"`
+	// UnknownTags holds the tags the Reader didn't recognize, in the order they were read
+	UnknownTags []UnknownTag `json:"unknownTags,omitempty"`
+	// Spans holds where each tag was found in the input, keyed by tag number such as {1500}.
+	// A repeated tag keeps the span of its last occurrence.
+	Spans map[string]Span `json:"spans,omitempty"`
+	// SourceFormat holds how each tag was formatted in the input. See ReaderOpts.PreserveFormat.
+	SourceFormat *SourceFormat `json:"-"`
 	// ValidateOpts
 	ValidateOptions *ValidateOpts `json:"validateOptions,omitempty"`
+
+	// readTags holds the known tags of a message read by Reader, in the order they were read
+	readTags []string
+}
+
+// isEmpty returns true when no tags have been set on the FEDWireMessage. ValidateOptions are ignored.
+func (fwm FEDWireMessage) isEmpty() bool {
+	return fwm.ID == "" && len(fwm.tagRecords()) == 0 && len(fwm.UnknownTags) == 0
 }
 
 func (fwm *FEDWireMessage) requireSenderSupplied() bool {
@@ -143,68 +162,26 @@ func (fwm *FEDWireMessage) requireSenderSupplied() bool {
 	return !opts.AllowMissingSenderSupplied
 }
 
-// verify checks basic WIRE rules. Assumes properly parsed records. Each validation func should
-// check for the expected relationships between fields within a FedWireMessage.
-func (fwm *FEDWireMessage) verify() error {
-
-	if err := fwm.mandatoryFields(); err != nil {
-		return err
-	}
-
-	if err := fwm.otherTransferInformation(); err != nil {
-		return err
-	}
-	if err := fwm.validateBeneficiaryIntermediaryFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateBeneficiaryFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateOriginatorFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateInstructingFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateOriginatorToBeneficiary(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIIntermediaryFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIIntermediaryFIAdvice(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIBeneficiaryFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIBeneficiaryFIAdvice(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIBeneficiary(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIBeneficiaryAdvice(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIPaymentMethodToBeneficiary(); err != nil {
-		return err
-	}
-	if err := fwm.validateUnstructuredAddenda(); err != nil {
-		return err
-	}
+// messageRule is a check of the relationships between tags within a FEDWireMessage
+type messageRule struct {
+	field string // FEDWireMessage field the rule is about
+	check func(fwm *FEDWireMessage) error
+}
 
-	if err := fwm.validateRelatedRemittance(); err != nil {
-		return err
+// run checks fwm against the rule unless it was turned off in fwm.ValidateOptions
+func (rule messageRule) run(fwm *FEDWireMessage) error {
+	if fwm.ValidateOptions.skips(rule.field) {
+		return nil
 	}
+	return rule.check(fwm)
+}
 
-	if err := fwm.isRemittanceValid(); err != nil {
-		return err
-	}
-	return nil
+// checkTagOrder returns true when fwm.ValidateOptions asks for the order of tags to be checked
+func (fwm *FEDWireMessage) checkTagOrder() bool {
+	return fwm.ValidateOptions != nil && fwm.ValidateOptions.CheckTagOrder && !fwm.ValidateOptions.SkipAll
 }
 
-// mandatoryFields validates mandatory tags for a FEDWireMessage are defined
+// mandatoryRules check the tags which are mandatory for every FEDWireMessage
 //
 //			At a minimum, the following tags are mandatory in each outgoing message sent from a DI to the Fedwire Funds Service
 //			(regardless of the business function code).
@@ -220,32 +197,576 @@ func (fwm *FEDWireMessage) verify() error {
 //
 //		 	NOTE: Not specified mandatory elements in each incoming message
 //	          Need to specify mandatory elements in this case
-func (fwm *FEDWireMessage) mandatoryFields() error {
-	if fwm.requireSenderSupplied() {
-		if err := fwm.validateSenderSupplied(); err != nil {
+var mandatoryRules = []messageRule{
+	{"SenderSupplied", func(fwm *FEDWireMessage) error {
+		if !fwm.requireSenderSupplied() {
+			return nil
+		}
+		return fwm.validateSenderSupplied()
+	}},
+	{"TypeSubType", (*FEDWireMessage).validateTypeSubType},
+	{"InputMessageAccountabilityData", func(fwm *FEDWireMessage) error {
+		if fwm.ValidateOptions != nil && fwm.ValidateOptions.SkipMandatoryIMAD {
+			return nil
+		}
+		return fwm.validateIMAD()
+	}},
+	{"Amount", (*FEDWireMessage).validateAmount},
+	{"SenderDepositoryInstitution", (*FEDWireMessage).validateSenderDI},
+	{"ReceiverDepositoryInstitution", (*FEDWireMessage).validateReceiverDI},
+	{"BusinessFunctionCode", (*FEDWireMessage).validateBusinessFunctionCode},
+}
+
+// crossTagRules check the tags which depend on other tags in a FEDWireMessage. They are run
+// once the mandatory tags are present.
+var crossTagRules = []messageRule{
+	{"LocalInstrument", (*FEDWireMessage).validateLocalInstrumentCode},
+	{"Charges", (*FEDWireMessage).validateCharges},
+	{"InstructedAmount", (*FEDWireMessage).validateInstructedAmount},
+	{"ExchangeRate", (*FEDWireMessage).validateExchangeRate},
+	{"BeneficiaryIntermediaryFI", (*FEDWireMessage).validateBeneficiaryIntermediaryFI},
+	{"BeneficiaryFI", (*FEDWireMessage).validateBeneficiaryFI},
+	{"OriginatorFI", (*FEDWireMessage).validateOriginatorFI},
+	{"InstructingFI", (*FEDWireMessage).validateInstructingFI},
+	{"OriginatorToBeneficiary", (*FEDWireMessage).validateOriginatorToBeneficiary},
+	{"FIIntermediaryFI", (*FEDWireMessage).validateFIIntermediaryFI},
+	{"FIIntermediaryFIAdvice", (*FEDWireMessage).validateFIIntermediaryFIAdvice},
+	{"FIBeneficiaryFI", (*FEDWireMessage).validateFIBeneficiaryFI},
+	{"FIBeneficiaryFIAdvice", (*FEDWireMessage).validateFIBeneficiaryFIAdvice},
+	{"FIBeneficiary", (*FEDWireMessage).validateFIBeneficiary},
+	{"FIBeneficiaryAdvice", (*FEDWireMessage).validateFIBeneficiaryAdvice},
+	{"FIPaymentMethodToBeneficiary", (*FEDWireMessage).validateFIPaymentMethodToBeneficiary},
+	{"UnstructuredAddenda", (*FEDWireMessage).validateUnstructuredAddenda},
+	{"RelatedRemittance", (*FEDWireMessage).validateRelatedRemittance},
+	{"RemittanceOriginator", (*FEDWireMessage).validateRemittanceOriginator},
+	{"RemittanceBeneficiary", (*FEDWireMessage).validateRemittanceBeneficiary},
+	{"PrimaryRemittanceDocument", (*FEDWireMessage).validatePrimaryRemittanceDocument},
+	{"ActualAmountPaid", (*FEDWireMessage).validateActualAmountPaid},
+	{"GrossAmountRemittanceDocument", (*FEDWireMessage).validateGrossAmountRemittanceDocument},
+	{"Adjustment", (*FEDWireMessage).validateAdjustment},
+	{"DateRemittanceDocument", (*FEDWireMessage).validateDateRemittanceDocument},
+	{"RemittanceFreeText", (*FEDWireMessage).validateRemittanceFreeText},
+}
+
+// verify checks basic WIRE rules. Assumes properly parsed records. Each validation func should
+// check for the expected relationships between fields within a FedWireMessage.
+func (fwm *FEDWireMessage) verify() error {
+	if err := fwm.mandatoryFields(); err != nil {
+		return err
+	}
+	for _, rule := range crossTagRules {
+		if err := rule.run(fwm); err != nil {
 			return err
 		}
 	}
-	if err := fwm.validateTypeSubType(); err != nil {
-		return err
+	if fwm.checkTagOrder() {
+		if errs := fwm.validateTagOrder(); len(errs) > 0 {
+			return errs[0]
+		}
 	}
-
-	if fwm.ValidateOptions == nil || !fwm.ValidateOptions.SkipMandatoryIMAD {
-		if err := fwm.validateIMAD(); err != nil {
+	for _, rule := range fwm.customRules() {
+		if err := rule.run(fwm); err != nil {
 			return err
 		}
 	}
-	if err := fwm.validateAmount(); err != nil {
-		return err
+	return nil
+}
+
+// ValidateAll runs the same rules as verify without stopping at the first error, then validates each
+// tag present in the message. Every failure is returned in a base.ErrorList of *FieldError, each holding
+// the tag number and field path of the problem. Each rule and tag reports the first error it finds.
+//
+// Cross-tag rules are skipped when TypeSubType or BusinessFunctionCode is missing, as they can't be evaluated.
+// Custom ValidationRules are only run once every built-in check has passed.
+func (fwm *FEDWireMessage) ValidateAll() error {
+	var errs base.ErrorList
+	add := func(rule messageRule) {
+		if err := rule.run(fwm); err != nil {
+			fe := newMessageFieldError(rule.field, err)
+			for i := range errs {
+				if errs[i].Error() == fe.Error() {
+					return
+				}
+			}
+			errs.Add(fe)
+		}
 	}
-	if err := fwm.validateSenderDI(); err != nil {
-		return err
+
+	for _, rule := range mandatoryRules {
+		add(rule)
 	}
-	if err := fwm.validateReceiverDI(); err != nil {
-		return err
+	if fwm.TypeSubType != nil && fwm.BusinessFunctionCode != nil {
+		for _, rule := range crossTagRules {
+			add(rule)
+		}
 	}
-	if err := fwm.validateBusinessFunctionCode(); err != nil {
-		return err
+	for _, tr := range fwm.tagRecords() {
+		add(messageRule{tr.field, func(fwm *FEDWireMessage) error { return tr.record.validate(fwm.ValidateOptions) }})
+	}
+	if fwm.checkTagOrder() {
+		for _, err := range fwm.validateTagOrder() {
+			errs.Add(newMessageFieldError("", err))
+		}
+	}
+	if errs.Empty() {
+		for _, rule := range fwm.customRules() {
+			add(rule)
+		}
+	}
+	if errs.Empty() {
+		return nil
+	}
+	return errs
+}
+
+// tagRecord is a tag held by a FEDWireMessage
+type tagRecord struct {
+	field  string // FEDWireMessage field holding the tag
+	record interface {
+		validate(opts *ValidateOpts) error
+		String() string
+	}
+}
+
+// tagRecords returns each tag present in the FEDWireMessage
+func (fwm *FEDWireMessage) tagRecords() []tagRecord {
+	var records []tagRecord
+	if fwm.MessageDisposition != nil {
+		records = append(records, tagRecord{"MessageDisposition", fwm.MessageDisposition})
+	}
+	if fwm.ReceiptTimeStamp != nil {
+		records = append(records, tagRecord{"ReceiptTimeStamp", fwm.ReceiptTimeStamp})
+	}
+	if fwm.OutputMessageAccountabilityData != nil {
+		records = append(records, tagRecord{"OutputMessageAccountabilityData", fwm.OutputMessageAccountabilityData})
+	}
+	if fwm.ErrorWire != nil {
+		records = append(records, tagRecord{"ErrorWire", fwm.ErrorWire})
+	}
+	if fwm.SenderSupplied != nil {
+		records = append(records, tagRecord{"SenderSupplied", fwm.SenderSupplied})
+	}
+	if fwm.TypeSubType != nil {
+		records = append(records, tagRecord{"TypeSubType", fwm.TypeSubType})
+	}
+	if fwm.InputMessageAccountabilityData != nil {
+		records = append(records, tagRecord{"InputMessageAccountabilityData", fwm.InputMessageAccountabilityData})
+	}
+	if fwm.Amount != nil {
+		records = append(records, tagRecord{"Amount", fwm.Amount})
+	}
+	if fwm.SenderDepositoryInstitution != nil {
+		records = append(records, tagRecord{"SenderDepositoryInstitution", fwm.SenderDepositoryInstitution})
+	}
+	if fwm.ReceiverDepositoryInstitution != nil {
+		records = append(records, tagRecord{"ReceiverDepositoryInstitution", fwm.ReceiverDepositoryInstitution})
+	}
+	if fwm.BusinessFunctionCode != nil {
+		records = append(records, tagRecord{"BusinessFunctionCode", fwm.BusinessFunctionCode})
+	}
+	if fwm.SenderReference != nil {
+		records = append(records, tagRecord{"SenderReference", fwm.SenderReference})
+	}
+	if fwm.PreviousMessageIdentifier != nil {
+		records = append(records, tagRecord{"PreviousMessageIdentifier", fwm.PreviousMessageIdentifier})
+	}
+	if fwm.LocalInstrument != nil {
+		records = append(records, tagRecord{"LocalInstrument", fwm.LocalInstrument})
+	}
+	if fwm.PaymentNotification != nil {
+		records = append(records, tagRecord{"PaymentNotification", fwm.PaymentNotification})
+	}
+	if fwm.Charges != nil {
+		records = append(records, tagRecord{"Charges", fwm.Charges})
+	}
+	if fwm.InstructedAmount != nil {
+		records = append(records, tagRecord{"InstructedAmount", fwm.InstructedAmount})
+	}
+	if fwm.ExchangeRate != nil {
+		records = append(records, tagRecord{"ExchangeRate", fwm.ExchangeRate})
+	}
+	if fwm.BeneficiaryIntermediaryFI != nil {
+		records = append(records, tagRecord{"BeneficiaryIntermediaryFI", fwm.BeneficiaryIntermediaryFI})
+	}
+	if fwm.BeneficiaryFI != nil {
+		records = append(records, tagRecord{"BeneficiaryFI", fwm.BeneficiaryFI})
+	}
+	if fwm.Beneficiary != nil {
+		records = append(records, tagRecord{"Beneficiary", fwm.Beneficiary})
+	}
+	if fwm.BeneficiaryReference != nil {
+		records = append(records, tagRecord{"BeneficiaryReference", fwm.BeneficiaryReference})
+	}
+	if fwm.AccountDebitedDrawdown != nil {
+		records = append(records, tagRecord{"AccountDebitedDrawdown", fwm.AccountDebitedDrawdown})
+	}
+	if fwm.Originator != nil {
+		records = append(records, tagRecord{"Originator", fwm.Originator})
+	}
+	if fwm.OriginatorOptionF != nil {
+		records = append(records, tagRecord{"OriginatorOptionF", fwm.OriginatorOptionF})
+	}
+	if fwm.OriginatorFI != nil {
+		records = append(records, tagRecord{"OriginatorFI", fwm.OriginatorFI})
+	}
+	if fwm.InstructingFI != nil {
+		records = append(records, tagRecord{"InstructingFI", fwm.InstructingFI})
+	}
+	if fwm.AccountCreditedDrawdown != nil {
+		records = append(records, tagRecord{"AccountCreditedDrawdown", fwm.AccountCreditedDrawdown})
+	}
+	if fwm.OriginatorToBeneficiary != nil {
+		records = append(records, tagRecord{"OriginatorToBeneficiary", fwm.OriginatorToBeneficiary})
+	}
+	if fwm.FIReceiverFI != nil {
+		records = append(records, tagRecord{"FIReceiverFI", fwm.FIReceiverFI})
+	}
+	if fwm.FIDrawdownDebitAccountAdvice != nil {
+		records = append(records, tagRecord{"FIDrawdownDebitAccountAdvice", fwm.FIDrawdownDebitAccountAdvice})
+	}
+	if fwm.FIIntermediaryFI != nil {
+		records = append(records, tagRecord{"FIIntermediaryFI", fwm.FIIntermediaryFI})
+	}
+	if fwm.FIIntermediaryFIAdvice != nil {
+		records = append(records, tagRecord{"FIIntermediaryFIAdvice", fwm.FIIntermediaryFIAdvice})
+	}
+	if fwm.FIBeneficiaryFI != nil {
+		records = append(records, tagRecord{"FIBeneficiaryFI", fwm.FIBeneficiaryFI})
+	}
+	if fwm.FIBeneficiaryFIAdvice != nil {
+		records = append(records, tagRecord{"FIBeneficiaryFIAdvice", fwm.FIBeneficiaryFIAdvice})
+	}
+	if fwm.FIBeneficiary != nil {
+		records = append(records, tagRecord{"FIBeneficiary", fwm.FIBeneficiary})
+	}
+	if fwm.FIBeneficiaryAdvice != nil {
+		records = append(records, tagRecord{"FIBeneficiaryAdvice", fwm.FIBeneficiaryAdvice})
+	}
+	if fwm.FIPaymentMethodToBeneficiary != nil {
+		records = append(records, tagRecord{"FIPaymentMethodToBeneficiary", fwm.FIPaymentMethodToBeneficiary})
+	}
+	if fwm.FIAdditionalFIToFI != nil {
+		records = append(records, tagRecord{"FIAdditionalFIToFI", fwm.FIAdditionalFIToFI})
+	}
+	if fwm.CurrencyInstructedAmount != nil {
+		records = append(records, tagRecord{"CurrencyInstructedAmount", fwm.CurrencyInstructedAmount})
+	}
+	if fwm.OrderingCustomer != nil {
+		records = append(records, tagRecord{"OrderingCustomer", fwm.OrderingCustomer})
+	}
+	if fwm.OrderingInstitution != nil {
+		records = append(records, tagRecord{"OrderingInstitution", fwm.OrderingInstitution})
+	}
+	if fwm.IntermediaryInstitution != nil {
+		records = append(records, tagRecord{"IntermediaryInstitution", fwm.IntermediaryInstitution})
+	}
+	if fwm.InstitutionAccount != nil {
+		records = append(records, tagRecord{"InstitutionAccount", fwm.InstitutionAccount})
+	}
+	if fwm.BeneficiaryCustomer != nil {
+		records = append(records, tagRecord{"BeneficiaryCustomer", fwm.BeneficiaryCustomer})
+	}
+	if fwm.Remittance != nil {
+		records = append(records, tagRecord{"Remittance", fwm.Remittance})
+	}
+	if fwm.SenderToReceiver != nil {
+		records = append(records, tagRecord{"SenderToReceiver", fwm.SenderToReceiver})
+	}
+	if fwm.UnstructuredAddenda != nil {
+		records = append(records, tagRecord{"UnstructuredAddenda", fwm.UnstructuredAddenda})
+	}
+	if fwm.RelatedRemittance != nil {
+		records = append(records, tagRecord{"RelatedRemittance", fwm.RelatedRemittance})
+	}
+	if fwm.RemittanceOriginator != nil {
+		records = append(records, tagRecord{"RemittanceOriginator", fwm.RemittanceOriginator})
+	}
+	if fwm.RemittanceBeneficiary != nil {
+		records = append(records, tagRecord{"RemittanceBeneficiary", fwm.RemittanceBeneficiary})
+	}
+	if fwm.PrimaryRemittanceDocument != nil {
+		records = append(records, tagRecord{"PrimaryRemittanceDocument", fwm.PrimaryRemittanceDocument})
+	}
+	if fwm.ActualAmountPaid != nil {
+		records = append(records, tagRecord{"ActualAmountPaid", fwm.ActualAmountPaid})
+	}
+	if fwm.GrossAmountRemittanceDocument != nil {
+		records = append(records, tagRecord{"GrossAmountRemittanceDocument", fwm.GrossAmountRemittanceDocument})
+	}
+	if fwm.AmountNegotiatedDiscount != nil {
+		records = append(records, tagRecord{"AmountNegotiatedDiscount", fwm.AmountNegotiatedDiscount})
+	}
+	if fwm.Adjustment != nil {
+		records = append(records, tagRecord{"Adjustment", fwm.Adjustment})
+	}
+	if fwm.DateRemittanceDocument != nil {
+		records = append(records, tagRecord{"DateRemittanceDocument", fwm.DateRemittanceDocument})
+	}
+	if fwm.SecondaryRemittanceDocument != nil {
+		records = append(records, tagRecord{"SecondaryRemittanceDocument", fwm.SecondaryRemittanceDocument})
+	}
+	if fwm.RemittanceFreeText != nil {
+		records = append(records, tagRecord{"RemittanceFreeText", fwm.RemittanceFreeText})
+	}
+	if fwm.ServiceMessage != nil {
+		records = append(records, tagRecord{"ServiceMessage", fwm.ServiceMessage})
+	}
+	return records
+}
+
+// tagRecord returns the record for tag, or nil if the FEDWireMessage doesn't hold it
+func (fwm *FEDWireMessage) tagRecord(tag string) interface{ String() string } { //nolint:gocyclo
+	switch tag {
+	case TagMessageDisposition:
+		if fwm.MessageDisposition != nil {
+			return fwm.MessageDisposition
+		}
+	case TagReceiptTimeStamp:
+		if fwm.ReceiptTimeStamp != nil {
+			return fwm.ReceiptTimeStamp
+		}
+	case TagOutputMessageAccountabilityData:
+		if fwm.OutputMessageAccountabilityData != nil {
+			return fwm.OutputMessageAccountabilityData
+		}
+	case TagErrorWire:
+		if fwm.ErrorWire != nil {
+			return fwm.ErrorWire
+		}
+	case TagSenderSupplied:
+		if fwm.SenderSupplied != nil {
+			return fwm.SenderSupplied
+		}
+	case TagTypeSubType:
+		if fwm.TypeSubType != nil {
+			return fwm.TypeSubType
+		}
+	case TagInputMessageAccountabilityData:
+		if fwm.InputMessageAccountabilityData != nil {
+			return fwm.InputMessageAccountabilityData
+		}
+	case TagAmount:
+		if fwm.Amount != nil {
+			return fwm.Amount
+		}
+	case TagSenderDepositoryInstitution:
+		if fwm.SenderDepositoryInstitution != nil {
+			return fwm.SenderDepositoryInstitution
+		}
+	case TagReceiverDepositoryInstitution:
+		if fwm.ReceiverDepositoryInstitution != nil {
+			return fwm.ReceiverDepositoryInstitution
+		}
+	case TagBusinessFunctionCode:
+		if fwm.BusinessFunctionCode != nil {
+			return fwm.BusinessFunctionCode
+		}
+	case TagSenderReference:
+		if fwm.SenderReference != nil {
+			return fwm.SenderReference
+		}
+	case TagPreviousMessageIdentifier:
+		if fwm.PreviousMessageIdentifier != nil {
+			return fwm.PreviousMessageIdentifier
+		}
+	case TagLocalInstrument:
+		if fwm.LocalInstrument != nil {
+			return fwm.LocalInstrument
+		}
+	case TagPaymentNotification:
+		if fwm.PaymentNotification != nil {
+			return fwm.PaymentNotification
+		}
+	case TagCharges:
+		if fwm.Charges != nil {
+			return fwm.Charges
+		}
+	case TagInstructedAmount:
+		if fwm.InstructedAmount != nil {
+			return fwm.InstructedAmount
+		}
+	case TagExchangeRate:
+		if fwm.ExchangeRate != nil {
+			return fwm.ExchangeRate
+		}
+	case TagBeneficiaryIntermediaryFI:
+		if fwm.BeneficiaryIntermediaryFI != nil {
+			return fwm.BeneficiaryIntermediaryFI
+		}
+	case TagBeneficiaryFI:
+		if fwm.BeneficiaryFI != nil {
+			return fwm.BeneficiaryFI
+		}
+	case TagBeneficiary:
+		if fwm.Beneficiary != nil {
+			return fwm.Beneficiary
+		}
+	case TagBeneficiaryReference:
+		if fwm.BeneficiaryReference != nil {
+			return fwm.BeneficiaryReference
+		}
+	case TagAccountDebitedDrawdown:
+		if fwm.AccountDebitedDrawdown != nil {
+			return fwm.AccountDebitedDrawdown
+		}
+	case TagOriginator:
+		if fwm.Originator != nil {
+			return fwm.Originator
+		}
+	case TagOriginatorOptionF:
+		if fwm.OriginatorOptionF != nil {
+			return fwm.OriginatorOptionF
+		}
+	case TagOriginatorFI:
+		if fwm.OriginatorFI != nil {
+			return fwm.OriginatorFI
+		}
+	case TagInstructingFI:
+		if fwm.InstructingFI != nil {
+			return fwm.InstructingFI
+		}
+	case TagAccountCreditedDrawdown:
+		if fwm.AccountCreditedDrawdown != nil {
+			return fwm.AccountCreditedDrawdown
+		}
+	case TagOriginatorToBeneficiary:
+		if fwm.OriginatorToBeneficiary != nil {
+			return fwm.OriginatorToBeneficiary
+		}
+	case TagFIReceiverFI:
+		if fwm.FIReceiverFI != nil {
+			return fwm.FIReceiverFI
+		}
+	case TagFIDrawdownDebitAccountAdvice:
+		if fwm.FIDrawdownDebitAccountAdvice != nil {
+			return fwm.FIDrawdownDebitAccountAdvice
+		}
+	case TagFIIntermediaryFI:
+		if fwm.FIIntermediaryFI != nil {
+			return fwm.FIIntermediaryFI
+		}
+	case TagFIIntermediaryFIAdvice:
+		if fwm.FIIntermediaryFIAdvice != nil {
+			return fwm.FIIntermediaryFIAdvice
+		}
+	case TagFIBeneficiaryFI:
+		if fwm.FIBeneficiaryFI != nil {
+			return fwm.FIBeneficiaryFI
+		}
+	case TagFIBeneficiaryFIAdvice:
+		if fwm.FIBeneficiaryFIAdvice != nil {
+			return fwm.FIBeneficiaryFIAdvice
+		}
+	case TagFIBeneficiary:
+		if fwm.FIBeneficiary != nil {
+			return fwm.FIBeneficiary
+		}
+	case TagFIBeneficiaryAdvice:
+		if fwm.FIBeneficiaryAdvice != nil {
+			return fwm.FIBeneficiaryAdvice
+		}
+	case TagFIPaymentMethodToBeneficiary:
+		if fwm.FIPaymentMethodToBeneficiary != nil {
+			return fwm.FIPaymentMethodToBeneficiary
+		}
+	case TagFIAdditionalFIToFI:
+		if fwm.FIAdditionalFIToFI != nil {
+			return fwm.FIAdditionalFIToFI
+		}
+	case TagCurrencyInstructedAmount:
+		if fwm.CurrencyInstructedAmount != nil {
+			return fwm.CurrencyInstructedAmount
+		}
+	case TagOrderingCustomer:
+		if fwm.OrderingCustomer != nil {
+			return fwm.OrderingCustomer
+		}
+	case TagOrderingInstitution:
+		if fwm.OrderingInstitution != nil {
+			return fwm.OrderingInstitution
+		}
+	case TagIntermediaryInstitution:
+		if fwm.IntermediaryInstitution != nil {
+			return fwm.IntermediaryInstitution
+		}
+	case TagInstitutionAccount:
+		if fwm.InstitutionAccount != nil {
+			return fwm.InstitutionAccount
+		}
+	case TagBeneficiaryCustomer:
+		if fwm.BeneficiaryCustomer != nil {
+			return fwm.BeneficiaryCustomer
+		}
+	case TagRemittance:
+		if fwm.Remittance != nil {
+			return fwm.Remittance
+		}
+	case TagSenderToReceiver:
+		if fwm.SenderToReceiver != nil {
+			return fwm.SenderToReceiver
+		}
+	case TagUnstructuredAddenda:
+		if fwm.UnstructuredAddenda != nil {
+			return fwm.UnstructuredAddenda
+		}
+	case TagRelatedRemittance:
+		if fwm.RelatedRemittance != nil {
+			return fwm.RelatedRemittance
+		}
+	case TagRemittanceOriginator:
+		if fwm.RemittanceOriginator != nil {
+			return fwm.RemittanceOriginator
+		}
+	case TagRemittanceBeneficiary:
+		if fwm.RemittanceBeneficiary != nil {
+			return fwm.RemittanceBeneficiary
+		}
+	case TagPrimaryRemittanceDocument:
+		if fwm.PrimaryRemittanceDocument != nil {
+			return fwm.PrimaryRemittanceDocument
+		}
+	case TagActualAmountPaid:
+		if fwm.ActualAmountPaid != nil {
+			return fwm.ActualAmountPaid
+		}
+	case TagGrossAmountRemittanceDocument:
+		if fwm.GrossAmountRemittanceDocument != nil {
+			return fwm.GrossAmountRemittanceDocument
+		}
+	case TagAmountNegotiatedDiscount:
+		if fwm.AmountNegotiatedDiscount != nil {
+			return fwm.AmountNegotiatedDiscount
+		}
+	case TagAdjustment:
+		if fwm.Adjustment != nil {
+			return fwm.Adjustment
+		}
+	case TagDateRemittanceDocument:
+		if fwm.DateRemittanceDocument != nil {
+			return fwm.DateRemittanceDocument
+		}
+	case TagSecondaryRemittanceDocument:
+		if fwm.SecondaryRemittanceDocument != nil {
+			return fwm.SecondaryRemittanceDocument
+		}
+	case TagRemittanceFreeText:
+		if fwm.RemittanceFreeText != nil {
+			return fwm.RemittanceFreeText
+		}
+	case TagServiceMessage:
+		if fwm.ServiceMessage != nil {
+			return fwm.ServiceMessage
+		}
+	}
+	return nil
+}
+
+// mandatoryFields validates mandatory tags for a FEDWireMessage are defined. See mandatoryRules.
+func (fwm *FEDWireMessage) mandatoryFields() error {
+	for _, rule := range mandatoryRules {
+		if err := rule.run(fwm); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -256,7 +777,7 @@ func (fwm *FEDWireMessage) validateSenderSupplied() error {
 	if fwm.SenderSupplied == nil {
 		return fieldError("SenderSupplied", ErrFieldRequired)
 	}
-	return fwm.SenderSupplied.Validate()
+	return fwm.SenderSupplied.validate(fwm.ValidateOptions)
 }
 
 // validateTypeSubType validates TagTypeSubType within a FEDWireMessage
@@ -265,7 +786,7 @@ func (fwm *FEDWireMessage) validateTypeSubType() error {
 	if fwm.TypeSubType == nil {
 		return fieldError("TypeSubType", ErrFieldRequired)
 	}
-	return fwm.TypeSubType.Validate()
+	return fwm.TypeSubType.validate(fwm.ValidateOptions)
 }
 
 // validateIMAD validates TagInputMessageAccountabilityData within a FEDWireMessage
@@ -274,7 +795,7 @@ func (fwm *FEDWireMessage) validateIMAD() error {
 	if fwm.InputMessageAccountabilityData == nil {
 		return fieldError("InputMessageAccountabilityData", ErrFieldRequired)
 	}
-	return fwm.InputMessageAccountabilityData.Validate()
+	return fwm.InputMessageAccountabilityData.validate(fwm.ValidateOptions)
 }
 
 // validateAmount validates TagAmount within a FEDWireMessage
@@ -284,11 +805,11 @@ func (fwm *FEDWireMessage) validateAmount() error {
 	if fwm.Amount == nil {
 		return fieldError("Amount", ErrFieldRequired)
 	}
-	if fwm.Amount.Amount == "000000000000" && fwm.TypeSubType.SubTypeCode != "90" {
+	if fwm.Amount.Amount == "000000000000" && fwm.TypeSubType != nil && fwm.TypeSubType.SubTypeCode != "90" {
 		return NewErrInvalidPropertyForProperty("Amount", fwm.Amount.Amount,
 			"SubTypeCode", fwm.TypeSubType.SubTypeCode)
 	}
-	return fwm.Amount.Validate()
+	return fwm.Amount.validate(fwm.ValidateOptions)
 }
 
 // validateSenderDI validates TagSenderDepositoryInstitution within a FEDWireMessage
@@ -297,7 +818,7 @@ func (fwm *FEDWireMessage) validateSenderDI() error {
 	if fwm.SenderDepositoryInstitution == nil {
 		return fieldError("SenderDepositoryInstitution", ErrFieldRequired)
 	}
-	return fwm.SenderDepositoryInstitution.Validate()
+	return fwm.SenderDepositoryInstitution.validate(fwm.ValidateOptions)
 }
 
 // validateReceiverDI validates TagReceiverDepositoryInstitution within a FEDWireMessage
@@ -306,7 +827,7 @@ func (fwm *FEDWireMessage) validateReceiverDI() error {
 	if fwm.ReceiverDepositoryInstitution == nil {
 		return fieldError("ReceiverDepositoryInstitution", ErrFieldRequired)
 	}
-	return fwm.ReceiverDepositoryInstitution.Validate()
+	return fwm.ReceiverDepositoryInstitution.validate(fwm.ValidateOptions)
 }
 
 // validateBusinessFunctionCode validates TagBusinessFunctionCode within a FEDWireMessage
@@ -315,6 +836,9 @@ func (fwm *FEDWireMessage) validateBusinessFunctionCode() error {
 	if fwm.BusinessFunctionCode == nil {
 		return fieldError("BusinessFunctionCode", ErrFieldRequired)
 	}
+	if fwm.TypeSubType == nil {
+		return fwm.BusinessFunctionCode.validate(fwm.ValidateOptions)
+	}
 
 	switch fwm.BusinessFunctionCode.BusinessFunctionCode {
 	case BankTransfer:
@@ -362,7 +886,7 @@ func (fwm *FEDWireMessage) validateBusinessFunctionCode() error {
 			return err
 		}
 	}
-	return fwm.BusinessFunctionCode.Validate()
+	return fwm.BusinessFunctionCode.validate(fwm.ValidateOptions)
 }
 
 // validateBankTransfer validates the BankTransfer code and associated tags
@@ -393,6 +917,9 @@ func (fwm *FEDWireMessage) validateBankTransfer() error {
 //	OriginatorOptionF, AccountCreditedDrawdown, FIDrawdownDebitAccountAdvice, Any CoverPayment Information tag ({7xxx}),
 //	Any UnstructuredAddenda or remittance tags ({8xxx}), and ServiceMessage
 func (fwm *FEDWireMessage) checkProhibitedBankTransferTags() error {
+	if fwm.ValidateOptions.skips(RuleProhibitedBankTransferTags) {
+		return nil
+	}
 	if fwm.BusinessFunctionCode != nil {
 		if strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode) != "" {
 			return fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode)
@@ -460,16 +987,25 @@ func (fwm *FEDWireMessage) validateCustomerTransfer() error {
 // Additional mandatory tags: Beneficiary, Originator
 // If TypeSubType = ReversalTransfer or ReversalPriorDayTransfer, then PreviousMessageIdentifier is mandatory.
 func (fwm *FEDWireMessage) checkMandatoryCustomerTransferTags() error {
+	if missing := fwm.missingCustomerTransferTags(); len(missing) > 0 {
+		return missing[0]
+	}
+	return nil
+}
+
+// missingCustomerTransferTags returns an error for each tag required by checkMandatoryCustomerTransferTags which is missing
+func (fwm *FEDWireMessage) missingCustomerTransferTags() []error {
+	var missing []error
 	if fwm.Beneficiary == nil {
-		return fieldError("Beneficiary", ErrFieldRequired)
+		missing = append(missing, fieldError("Beneficiary", ErrFieldRequired))
 	}
 	if fwm.Originator == nil {
-		return fieldError("Originator", ErrFieldRequired)
+		missing = append(missing, fieldError("Originator", ErrFieldRequired))
 	}
 	if err := fwm.checkPreviousMessageIdentifier(); err != nil {
-		return err
+		missing = append(missing, err)
 	}
-	return nil
+	return missing
 }
 
 // checkProhibitedCustomerTransferTags ensures there are no tags present in the message that are incompatible with the CustomerTransfer code
@@ -543,14 +1079,23 @@ func (fwm *FEDWireMessage) validateCustomerTransferPlus() error {
 // If LocalInstrument = RemittanceInformationStructured, then RemittanceOriginator, RemittanceBeneficiary, PrimaryRemittanceDocument & ActualAmountPaid are mandatory.
 // If LocalInstrument = ProprietaryLocalInstrumentCode, then LocalInstrument Element 02 is mandatory.
 func (fwm *FEDWireMessage) checkMandatoryCustomerTransferPlusTags() error {
+	if missing := fwm.missingCustomerTransferPlusTags(); len(missing) > 0 {
+		return missing[0]
+	}
+	return nil
+}
+
+// missingCustomerTransferPlusTags returns an error for each tag required by checkMandatoryCustomerTransferPlusTags which is missing
+func (fwm *FEDWireMessage) missingCustomerTransferPlusTags() []error {
+	var missing []error
 	if fwm.Beneficiary == nil {
-		return fieldError("Beneficiary", ErrFieldRequired)
+		missing = append(missing, fieldError("Beneficiary", ErrFieldRequired))
 	}
 	if fwm.Originator == nil && fwm.OriginatorOptionF == nil { // one or the other must be present
-		return fieldError("Originator OR OriginatorOptionF", ErrFieldRequired)
+		missing = append(missing, fieldError("Originator OR OriginatorOptionF", ErrFieldRequired))
 	}
 	if err := fwm.checkPreviousMessageIdentifier(); err != nil {
-		return err
+		missing = append(missing, err)
 	}
 
 	// LocalInstrument is optional for Customer Transfer Plus
@@ -558,44 +1103,44 @@ func (fwm *FEDWireMessage) checkMandatoryCustomerTransferPlusTags() error {
 		switch fwm.LocalInstrument.LocalInstrumentCode {
 		case SequenceBCoverPaymentStructured:
 			if fwm.BeneficiaryReference == nil {
-				return fieldError("BeneficiaryReference", ErrFieldRequired)
+				missing = append(missing, fieldError("BeneficiaryReference", ErrFieldRequired))
 			}
 			if fwm.OrderingCustomer == nil {
-				return fieldError("OrderingCustomer", ErrFieldRequired)
+				missing = append(missing, fieldError("OrderingCustomer", ErrFieldRequired))
 			}
 			if fwm.BeneficiaryCustomer == nil {
-				return fieldError("BeneficiaryCustomer", ErrFieldRequired)
+				missing = append(missing, fieldError("BeneficiaryCustomer", ErrFieldRequired))
 			}
 		case ANSIX12format, GeneralXMLformat, ISO20022XMLformat,
 			NarrativeText, STP820format, SWIFTfield70, UNEDIFACTformat:
 			if fwm.UnstructuredAddenda == nil {
-				return fieldError("UnstructuredAddenda", ErrFieldRequired)
+				missing = append(missing, fieldError("UnstructuredAddenda", ErrFieldRequired))
 			}
 		case RelatedRemittanceInformation:
 			if fwm.RelatedRemittance == nil {
-				return fieldError("RelatedRemittance", ErrFieldRequired)
+				missing = append(missing, fieldError("RelatedRemittance", ErrFieldRequired))
 			}
 		case RemittanceInformationStructured:
 			if fwm.RemittanceOriginator == nil {
-				return fieldError("RemittanceOriginator", ErrFieldRequired)
+				missing = append(missing, fieldError("RemittanceOriginator", ErrFieldRequired))
 			}
 			if fwm.RemittanceBeneficiary == nil {
-				return fieldError("RemittanceBeneficiary", ErrFieldRequired)
+				missing = append(missing, fieldError("RemittanceBeneficiary", ErrFieldRequired))
 			}
 			if fwm.PrimaryRemittanceDocument == nil {
-				return fieldError("PrimaryRemittanceDocument", ErrFieldRequired)
+				missing = append(missing, fieldError("PrimaryRemittanceDocument", ErrFieldRequired))
 			}
 			if fwm.ActualAmountPaid == nil {
-				return fieldError("ActualAmountPaid", ErrFieldRequired)
+				missing = append(missing, fieldError("ActualAmountPaid", ErrFieldRequired))
 			}
 		case ProprietaryLocalInstrumentCode:
 			if fwm.LocalInstrument.ProprietaryCode == "" {
-				return fieldError("ProprietaryCode", ErrFieldRequired)
+				missing = append(missing, fieldError("ProprietaryCode", ErrFieldRequired))
 			}
 		}
 	}
 
-	return nil
+	return missing
 }
 
 // checkProhibitedCustomerTransferPlusTags ensures there are no tags present in the message that are incompatible with the CustomerTransferPlus code
@@ -606,6 +1151,9 @@ func (fwm *FEDWireMessage) checkMandatoryCustomerTransferPlusTags() error {
 // If LocalInstrument = SequenceBCoverPaymentStructured, Charges, InstructedAmount & ExchangeRate are not permitted.
 // Certain {7xxx} tags & {8xxx} tags may not be permitted depending upon value of LocalInstrument.
 func (fwm *FEDWireMessage) checkProhibitedCustomerTransferPlusTags() error {
+	if fwm.ValidateOptions.skips(RuleProhibitedCustomerTransferPlusTags) {
+		return nil
+	}
 	if strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode) != "" {
 		return fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode)
 	}
@@ -713,13 +1261,22 @@ func (fwm *FEDWireMessage) validateDrawdownResponse() error {
 // checkMandatoryDrawdownResponseTags checks for the tags required by DrawdownResponse in addition to the standard mandatoryFields
 // Additional mandatory fields: Beneficiary, Originator
 func (fwm *FEDWireMessage) checkMandatoryDrawdownResponseTags() error {
+	if missing := fwm.missingDrawdownResponseTags(); len(missing) > 0 {
+		return missing[0]
+	}
+	return nil
+}
+
+// missingDrawdownResponseTags returns an error for each tag required by checkMandatoryDrawdownResponseTags which is missing
+func (fwm *FEDWireMessage) missingDrawdownResponseTags() []error {
+	var missing []error
 	if fwm.Beneficiary == nil {
-		return fieldError("Beneficiary", ErrFieldRequired)
+		missing = append(missing, fieldError("Beneficiary", ErrFieldRequired))
 	}
 	if fwm.Originator == nil {
-		return fieldError("Originator", ErrFieldRequired)
+		missing = append(missing, fieldError("Originator", ErrFieldRequired))
 	}
-	return nil
+	return missing
 }
 
 // validateBankDrawdownRequest validates the BankDrawDownRequest business function code
@@ -738,13 +1295,22 @@ func (fwm *FEDWireMessage) validateBankDrawdownRequest() error {
 // checkMandatoryBankDrawdownRequestTags checks for the tags required by BankDrawDownRequest in addition to the standard mandatoryFields
 // Additional mandatory fields: AccountDebitedDrawdown, AccountCreditedDrawdown
 func (fwm *FEDWireMessage) checkMandatoryBankDrawdownRequestTags() error {
+	if missing := fwm.missingBankDrawdownRequestTags(); len(missing) > 0 {
+		return missing[0]
+	}
+	return nil
+}
+
+// missingBankDrawdownRequestTags returns an error for each tag required by checkMandatoryBankDrawdownRequestTags which is missing
+func (fwm *FEDWireMessage) missingBankDrawdownRequestTags() []error {
+	var missing []error
 	if fwm.AccountDebitedDrawdown == nil {
-		return fieldError("AccountDebitedDrawdown", ErrFieldRequired)
+		missing = append(missing, fieldError("AccountDebitedDrawdown", ErrFieldRequired))
 	}
 	if fwm.AccountCreditedDrawdown == nil {
-		return fieldError("AccountCreditedDrawdown", ErrFieldRequired)
+		missing = append(missing, fieldError("AccountCreditedDrawdown", ErrFieldRequired))
 	}
-	return nil
+	return missing
 }
 
 // validateCustomerCorporateDrawdownRequest validates the CustomerCorporateDrawdownRequest business function code
@@ -763,16 +1329,25 @@ func (fwm *FEDWireMessage) validateCustomerCorporateDrawdownRequest() error {
 // checkMandatoryCustomerCorporateDrawdownRequestTags checks for the tags required by CustomerCorporateDrawdownRequest in addition to the standard mandatoryFields
 // Additional mandatory fields: Beneficiary, AccountDebitedDrawdown, AccountCreditedDrawdown
 func (fwm *FEDWireMessage) checkMandatoryCustomerCorporateDrawdownRequestTags() error {
+	if missing := fwm.missingCustomerCorporateDrawdownRequestTags(); len(missing) > 0 {
+		return missing[0]
+	}
+	return nil
+}
+
+// missingCustomerCorporateDrawdownRequestTags returns an error for each tag required by checkMandatoryCustomerCorporateDrawdownRequestTags which is missing
+func (fwm *FEDWireMessage) missingCustomerCorporateDrawdownRequestTags() []error {
+	var missing []error
 	if fwm.Beneficiary == nil {
-		return fieldError("Beneficiary", ErrFieldRequired)
+		missing = append(missing, fieldError("Beneficiary", ErrFieldRequired))
 	}
 	if fwm.AccountDebitedDrawdown == nil {
-		return fieldError("AccountDebitedDrawdown", ErrFieldRequired)
+		missing = append(missing, fieldError("AccountDebitedDrawdown", ErrFieldRequired))
 	}
 	if fwm.AccountCreditedDrawdown == nil {
-		return fieldError("AccountCreditedDrawdown", ErrFieldRequired)
+		missing = append(missing, fieldError("AccountCreditedDrawdown", ErrFieldRequired))
 	}
-	return nil
+	return missing
 }
 
 // validateServiceMessage validates the BFCServiceMessage business function code
@@ -795,6 +1370,9 @@ func (fwm *FEDWireMessage) validateServiceMessage() error {
 //	Beneficiary Code = SWIFTBICORBEIANDAccountNumber, Originator Code = SWIFTBICORBEIANDAccountNumber, OriginatorOptionF,
 //	any {7xxx} tag, any {8xxx} tag
 func (fwm *FEDWireMessage) checkProhibitedServiceMessageTags() error {
+	if fwm.ValidateOptions.skips(RuleProhibitedServiceMessageTags) {
+		return nil
+	}
 	// BusinessFunctionCode.TransactionTypeCode (Element 02) is invalid
 	if fwm.BusinessFunctionCode != nil {
 		if strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode) != "" {
@@ -841,6 +1419,9 @@ func (fwm *FEDWireMessage) checkProhibitedServiceMessageTags() error {
 // BusinessFunctionCode, create function isInvalidBusinessFunctionCodeTag() with the specific invalid tags for that
 // BusinessFunctionCode (e.g. checkProhibitedBankTransferTags)
 func (fwm *FEDWireMessage) checkSharedProhibitedTags() error {
+	if fwm.ValidateOptions.skips(RuleSharedProhibitedTags) {
+		return nil
+	}
 	// shared between CheckSameDaySettlement, DepositSendersAccount, FEDFundsReturned, FEDFundsSold, DrawdownResponse, BankDrawDownRequest, and CustomerCorporateDrawdownRequest
 	if strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode) != "" {
 		return fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode)
@@ -907,6 +1488,9 @@ func (fwm *FEDWireMessage) checkSharedProhibitedTags() error {
 // invalidRemittanceTags returns an error if certain {8xxx} range tags are present.
 // The validity of these tags generally depends on the value of the LocalInstrument tag.
 func (fwm *FEDWireMessage) invalidRemittanceTags() error {
+	if fwm.ValidateOptions.skips(RuleProhibitedRemittanceTags) {
+		return nil
+	}
 	if fwm.RelatedRemittance != nil {
 		return fieldError("RelatedRemittance", ErrInvalidProperty, fwm.RelatedRemittance)
 	}
@@ -946,6 +1530,9 @@ func (fwm *FEDWireMessage) invalidRemittanceTags() error {
 // invalidCoverPaymentTags returns an error if certain {7xxx} range tags are present.
 // The validity of these tags generally depends on the value of the LocalInstrument tag.
 func (fwm *FEDWireMessage) invalidCoverPaymentTags() error {
+	if fwm.ValidateOptions.skips(RuleProhibitedCoverPaymentTags) {
+		return nil
+	}
 	if fwm.CurrencyInstructedAmount != nil {
 		return fieldError("CurrencyInstructedAmount", ErrInvalidProperty, fwm.CurrencyInstructedAmount)
 	}
@@ -979,7 +1566,7 @@ func (fwm *FEDWireMessage) validateLocalInstrumentCode() error {
 		if fwm.BusinessFunctionCode.BusinessFunctionCode != CustomerTransferPlus {
 			return fieldError("LocalInstrument", ErrLocalInstrumentNotPermitted)
 		}
-		return fwm.LocalInstrument.Validate()
+		return fwm.LocalInstrument.validate(fwm.ValidateOptions)
 	}
 	return nil
 
@@ -996,7 +1583,7 @@ func (fwm *FEDWireMessage) validateCharges() error {
 			return NewErrInvalidPropertyForProperty("LocalInstrumentCode", fwm.LocalInstrument.LocalInstrumentCode,
 				"Charges", fwm.Charges.String())
 		}
-		return fwm.Charges.Validate()
+		return fwm.Charges.validate(fwm.ValidateOptions)
 	}
 	return nil
 }
@@ -1018,7 +1605,7 @@ func (fwm *FEDWireMessage) validateInstructedAmount() error {
 			return NewErrInvalidPropertyForProperty("LocalInstrumentCode",
 				fwm.LocalInstrument.LocalInstrumentCode, "Instructed Amount", fwm.InstructedAmount.String())
 		}
-		return fwm.InstructedAmount.Validate()
+		return fwm.InstructedAmount.validate(fwm.ValidateOptions)
 	}
 	return nil
 }
@@ -1040,7 +1627,7 @@ func (fwm *FEDWireMessage) validateExchangeRate() error {
 			return NewErrInvalidPropertyForProperty("LocalInstrumentCode",
 				fwm.LocalInstrument.LocalInstrumentCode, "ExchangeRate", fwm.ExchangeRate.ExchangeRate)
 		}
-		return fwm.ExchangeRate.Validate()
+		return fwm.ExchangeRate.validate(fwm.ValidateOptions)
 	}
 	return nil
 }
@@ -1054,7 +1641,7 @@ func (fwm *FEDWireMessage) validateBeneficiaryIntermediaryFI() error {
 		if fwm.Beneficiary == nil {
 			return fieldError("Beneficiary", ErrFieldRequired)
 		}
-		return fwm.BeneficiaryIntermediaryFI.Validate()
+		return fwm.BeneficiaryIntermediaryFI.validate(fwm.ValidateOptions)
 	}
 	return nil
 }
@@ -1065,7 +1652,7 @@ func (fwm *FEDWireMessage) validateBeneficiaryFI() error {
 		if fwm.Beneficiary == nil {
 			return fieldError("Beneficiary", ErrFieldRequired)
 		}
-		return fwm.BeneficiaryFI.Validate()
+		return fwm.BeneficiaryFI.validate(fwm.ValidateOptions)
 	}
 	return nil
 }
@@ -1083,7 +1670,7 @@ func (fwm *FEDWireMessage) validateOriginatorFI() error {
 				return fieldError("Originator", ErrFieldRequired)
 			}
 		}
-		return fwm.OriginatorFI.Validate()
+		return fwm.OriginatorFI.validate(fwm.ValidateOptions)
 	}
 	return nil
 }
@@ -1104,7 +1691,7 @@ func (fwm *FEDWireMessage) validateInstructingFI() error {
 		if fwm.OriginatorFI == nil {
 			return fieldError("OriginatorFI", ErrFieldRequired)
 		}
-		return fwm.InstructingFI.Validate()
+		return fwm.InstructingFI.validate(fwm.ValidateOptions)
 	}
 	return nil
 }
@@ -1125,7 +1712,7 @@ func (fwm *FEDWireMessage) validateOriginatorToBeneficiary() error {
 				return fieldError("Originator", ErrFieldRequired)
 			}
 		}
-		return fwm.OriginatorToBeneficiary.Validate()
+		return fwm.OriginatorToBeneficiary.validate(fwm.ValidateOptions)
 	}
 	return nil
 }
@@ -1143,7 +1730,7 @@ func (fwm *FEDWireMessage) validateFIIntermediaryFI() error {
 		if fwm.Beneficiary == nil {
 			return fieldError("Beneficiary", ErrFieldRequired)
 		}
-		return fwm.FIIntermediaryFI.Validate()
+		return fwm.FIIntermediaryFI.validate(fwm.ValidateOptions)
 	}
 	return nil
 }
@@ -1161,7 +1748,7 @@ func (fwm *FEDWireMessage) validateFIIntermediaryFIAdvice() error {
 		if fwm.Beneficiary == nil {
 			return fieldError("Beneficiary", ErrFieldRequired)
 		}
-		return fwm.FIIntermediaryFIAdvice.Validate()
+		return fwm.FIIntermediaryFIAdvice.validate(fwm.ValidateOptions)
 	}
 	return nil
 }
@@ -1176,7 +1763,7 @@ func (fwm *FEDWireMessage) validateFIBeneficiaryFI() error {
 		if fwm.Beneficiary == nil {
 			return fieldError("Beneficiary", ErrFieldRequired)
 		}
-		return fwm.FIBeneficiaryFI.Validate()
+		return fwm.FIBeneficiaryFI.validate(fwm.ValidateOptions)
 	}
 	return nil
 }
@@ -1191,7 +1778,7 @@ func (fwm *FEDWireMessage) validateFIBeneficiaryFIAdvice() error {
 		if fwm.Beneficiary == nil {
 			return fieldError("Beneficiary", ErrFieldRequired)
 		}
-		return fwm.FIBeneficiaryFIAdvice.Validate()
+		return fwm.FIBeneficiaryFIAdvice.validate(fwm.ValidateOptions)
 	}
 	return nil
 }
@@ -1203,7 +1790,7 @@ func (fwm *FEDWireMessage) validateFIBeneficiary() error {
 		if fwm.Beneficiary == nil {
 			return fieldError("Beneficiary", ErrFieldRequired)
 		}
-		return fwm.FIBeneficiary.Validate()
+		return fwm.FIBeneficiary.validate(fwm.ValidateOptions)
 	}
 	return nil
 }
@@ -1215,7 +1802,7 @@ func (fwm *FEDWireMessage) validateFIBeneficiaryAdvice() error {
 		if fwm.Beneficiary == nil {
 			return fieldError("Beneficiary", ErrFieldRequired)
 		}
-		return fwm.FIBeneficiaryAdvice.Validate()
+		return fwm.FIBeneficiaryAdvice.validate(fwm.ValidateOptions)
 	}
 	return nil
 }
@@ -1230,7 +1817,7 @@ func (fwm *FEDWireMessage) validateFIPaymentMethodToBeneficiary() error {
 		if fwm.Beneficiary == nil {
 			return fieldError("Beneficiary", ErrFieldRequired)
 		}
-		return fwm.FIPaymentMethodToBeneficiary.Validate()
+		return fwm.FIPaymentMethodToBeneficiary.validate(fwm.ValidateOptions)
 	}
 	return nil
 }
@@ -1251,7 +1838,7 @@ func (fwm *FEDWireMessage) validateUnstructuredAddenda() error {
 			if fwm.UnstructuredAddenda == nil {
 				return fieldError("UnstructuredAddenda", ErrFieldRequired)
 			}
-			return fwm.UnstructuredAddenda.Validate()
+			return fwm.UnstructuredAddenda.validate(fwm.ValidateOptions)
 		default:
 			if fwm.UnstructuredAddenda != nil {
 				return NewErrInvalidPropertyForProperty("UnstructuredAddenda", fwm.UnstructuredAddenda.String(),
@@ -1280,7 +1867,7 @@ func (fwm *FEDWireMessage) validateRelatedRemittance() error {
 		if fwm.RelatedRemittance == nil {
 			return fieldError("RelatedRemittance", ErrFieldRequired)
 		}
-		return fwm.RelatedRemittance.Validate()
+		return fwm.RelatedRemittance.validate(fwm.ValidateOptions)
 	} else {
 		if fwm.RelatedRemittance != nil {
 			return fieldError("RelatedRemittance", ErrNotPermitted)
@@ -1300,7 +1887,7 @@ func (fwm *FEDWireMessage) validateRemittanceOriginator() error {
 		if fwm.RemittanceOriginator == nil {
 			return fieldError("RemittanceOriginator", ErrFieldRequired)
 		}
-		return fwm.RemittanceOriginator.Validate()
+		return fwm.RemittanceOriginator.validate(fwm.ValidateOptions)
 	} else {
 		if fwm.RemittanceOriginator != nil {
 			return fieldError("RemittanceOriginator", ErrNotPermitted)
@@ -1320,7 +1907,7 @@ func (fwm *FEDWireMessage) validateRemittanceBeneficiary() error {
 		if fwm.RemittanceBeneficiary == nil {
 			return fieldError("RemittanceBeneficiary", ErrFieldRequired)
 		}
-		return fwm.RemittanceBeneficiary.Validate()
+		return fwm.RemittanceBeneficiary.validate(fwm.ValidateOptions)
 	} else {
 		if fwm.RemittanceBeneficiary != nil {
 			return fieldError("RemittanceBeneficiary", ErrNotPermitted)
@@ -1340,7 +1927,7 @@ func (fwm *FEDWireMessage) validatePrimaryRemittanceDocument() error {
 		if fwm.PrimaryRemittanceDocument == nil {
 			return fieldError("PrimaryRemittanceDocument", ErrFieldRequired)
 		}
-		return fwm.PrimaryRemittanceDocument.Validate()
+		return fwm.PrimaryRemittanceDocument.validate(fwm.ValidateOptions)
 	} else {
 		if fwm.PrimaryRemittanceDocument != nil {
 			return fieldError("PrimaryRemittanceDocument", ErrNotPermitted)
@@ -1360,7 +1947,7 @@ func (fwm *FEDWireMessage) validateActualAmountPaid() error {
 		if fwm.ActualAmountPaid == nil {
 			return fieldError("ActualAmountPaid", ErrFieldRequired)
 		}
-		return fwm.ActualAmountPaid.Validate()
+		return fwm.ActualAmountPaid.validate(fwm.ValidateOptions)
 	} else {
 		if fwm.ActualAmountPaid != nil {
 			return fieldError("ActualAmountPaid", ErrNotPermitted)
@@ -1380,7 +1967,7 @@ func (fwm *FEDWireMessage) validateGrossAmountRemittanceDocument() error {
 		if fwm.GrossAmountRemittanceDocument == nil {
 			return fieldError("GrossAmountRemittanceDocument", ErrFieldRequired)
 		}
-		return fwm.GrossAmountRemittanceDocument.Validate()
+		return fwm.GrossAmountRemittanceDocument.validate(fwm.ValidateOptions)
 	} else {
 		if fwm.GrossAmountRemittanceDocument != nil {
 			return fieldError("GrossAmountRemittanceDocument", ErrNotPermitted)
@@ -1400,7 +1987,7 @@ func (fwm *FEDWireMessage) validateAdjustment() error {
 		if fwm.Adjustment == nil {
 			return fieldError("Adjustment", ErrFieldRequired)
 		}
-		return fwm.Adjustment.Validate()
+		return fwm.Adjustment.validate(fwm.ValidateOptions)
 	} else {
 		if fwm.Adjustment != nil {
 			return fieldError("Adjustment", ErrNotPermitted)
@@ -1420,7 +2007,7 @@ func (fwm *FEDWireMessage) validateDateRemittanceDocument() error {
 		if fwm.DateRemittanceDocument == nil {
 			return fieldError("DateRemittanceDocument", ErrFieldRequired)
 		}
-		return fwm.DateRemittanceDocument.Validate()
+		return fwm.DateRemittanceDocument.validate(fwm.ValidateOptions)
 	} else {
 		if fwm.DateRemittanceDocument != nil {
 			return fieldError("DateRemittanceDocument", ErrNotPermitted)
@@ -1459,7 +2046,7 @@ func (fwm *FEDWireMessage) validateRemittanceFreeText() error {
 		if fwm.RemittanceFreeText == nil {
 			return fieldError("RemittanceFreeText", ErrFieldRequired)
 		}
-		return fwm.RemittanceFreeText.Validate()
+		return fwm.RemittanceFreeText.validate(fwm.ValidateOptions)
 	} else {
 		if fwm.RemittanceFreeText != nil {
 			return fieldError("RemittanceFreeText", ErrNotPermitted)
@@ -1468,47 +2055,3 @@ func (fwm *FEDWireMessage) validateRemittanceFreeText() error {
 
 	return nil
 }
-
-func (fwm *FEDWireMessage) otherTransferInformation() error {
-	if err := fwm.validateLocalInstrumentCode(); err != nil {
-		return err
-	}
-	if err := fwm.validateCharges(); err != nil {
-		return err
-	}
-	if err := fwm.validateInstructedAmount(); err != nil {
-		return err
-	}
-	if err := fwm.validateExchangeRate(); err != nil {
-		return err
-	}
-	return nil
-}
-
-func (fwm *FEDWireMessage) isRemittanceValid() error {
-	if err := fwm.validateRemittanceOriginator(); err != nil {
-		return err
-	}
-	if err := fwm.validateRemittanceBeneficiary(); err != nil {
-		return err
-	}
-	if err := fwm.validatePrimaryRemittanceDocument(); err != nil {
-		return err
-	}
-	if err := fwm.validateActualAmountPaid(); err != nil {
-		return err
-	}
-	if err := fwm.validateGrossAmountRemittanceDocument(); err != nil {
-		return err
-	}
-	if err := fwm.validateAdjustment(); err != nil {
-		return err
-	}
-	if err := fwm.validateDateRemittanceDocument(); err != nil {
-		return err
-	}
-	if err := fwm.validateRemittanceFreeText(); err != nil {
-		return err
-	}
-	return nil
-}
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/moov-io/base"
 	"github.com/stretchr/testify/require"
 )
 
@@ -1224,7 +1225,7 @@ func TestAllowFIReceiverFIForCustomerTransferPlus(t *testing.T) {
 {1510}1000
 {1520}20240306MMQFMPYZ012345
 {2000}000005000000
-{3100}021000000CITIBANK NA*
+{3100}021000089CITIBANK NA*
 {3320}D0440000000001*
 {3400}000000000AAA ABC BAN*
 {3600}CTP
@@ -1283,3 +1284,62 @@ func TestFEDWireMessage_skipIMAD(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, newFile.GetValidation().SkipMandatoryIMAD)
 }
+
+func TestFEDWireMessage_ValidateAll(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Originator = mockOriginator()
+	fwm.Amount.Amount = "00000000000A"
+	fwm.LocalInstrument = mockLocalInstrument()
+
+	require.Error(t, fwm.verify())
+
+	err := fwm.ValidateAll()
+	require.Error(t, err)
+
+	var errs base.ErrorList
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 3)
+
+	var paths []string
+	for i := range errs {
+		var fe *FieldError
+		require.ErrorAs(t, errs[i], &fe)
+		paths = append(paths, fe.Tag+" "+fe.Path)
+	}
+	require.Equal(t, []string{"{2000} Amount", "{4200} Beneficiary", "{3610} LocalInstrument"}, paths)
+
+	// a valid message has no errors
+	fwm = mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	require.NoError(t, fwm.ValidateAll())
+}
+
+func TestFEDWireMessage_ValidateAllMissingTags(t *testing.T) {
+	fwm := FEDWireMessage{}
+
+	var errs base.ErrorList
+	require.ErrorAs(t, fwm.ValidateAll(), &errs)
+	require.Len(t, errs, 7)
+	for i := range errs {
+		require.ErrorIs(t, errs[i], ErrFieldRequired)
+	}
+	require.Contains(t, errs[0].Error(), "{1500} SenderSupplied")
+}
+
+func TestFEDWireMessage_ValidateAllFieldPath(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.Name = "{Name}"
+	fwm.Originator = mockOriginator()
+
+	var errs base.ErrorList
+	require.ErrorAs(t, fwm.ValidateAll(), &errs)
+	require.Len(t, errs, 1)
+
+	var fe *FieldError
+	require.ErrorAs(t, errs[0], &fe)
+	require.Equal(t, TagBeneficiary, fe.Tag)
+	require.Equal(t, "Beneficiary.Name", fe.Path)
+	require.ErrorIs(t, fe, ErrNonAlphanumeric)
+}
@@ -0,0 +1,79 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+	"time"
+
+	"github.com/moov-io/wire/calendar"
+)
+
+// FedwireLocation is the America/New_York time zone, which the Fedwire Funds Service uses for
+// cycle dates and the timestamps of each message
+var FedwireLocation = calendar.Location
+
+// parseCCYYMMDD returns the start of a CCYYMMDD date in FedwireLocation
+func parseCCYYMMDD(s string) (time.Time, error) {
+	t, err := time.ParseInLocation("20060102", strings.TrimSpace(s), FedwireLocation)
+	if err != nil {
+		return time.Time{}, ErrValidDate
+	}
+	return t, nil
+}
+
+// formatCCYYMMDD returns the CCYYMMDD date of t in FedwireLocation
+func formatCCYYMMDD(t time.Time) string {
+	return t.In(FedwireLocation).Format("20060102")
+}
+
+// parseMMDDHHMM returns the time of a MMDD date and HHMM time in FedwireLocation. As the year isn't
+// given, the year which places the time closest to cycleDate is used, so a time on December 31st
+// before a cycle date of January 2nd is in the prior year.
+func parseMMDDHHMM(mmdd, hhmm string, cycleDate time.Time) (time.Time, error) {
+	t, err := time.ParseInLocation("01021504", strings.TrimSpace(mmdd)+strings.TrimSpace(hhmm), FedwireLocation)
+	if err != nil {
+		return time.Time{}, ErrValidDate
+	}
+
+	cycleDate = cycleDate.In(FedwireLocation)
+	var closest time.Time
+	for year := cycleDate.Year() - 1; year <= cycleDate.Year()+1; year++ {
+		candidate := time.Date(year, t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, FedwireLocation)
+		if candidate.Day() != t.Day() {
+			// February 29th outside of a leap year
+			continue
+		}
+		if closest.IsZero() || candidate.Sub(cycleDate).Abs() < closest.Sub(cycleDate).Abs() {
+			closest = candidate
+		}
+	}
+	if closest.IsZero() {
+		return time.Time{}, ErrValidDate
+	}
+	return closest, nil
+}
+
+// formatMMDDHHMM returns the MMDD date and HHMM time of t in FedwireLocation
+func formatMMDDHHMM(t time.Time) (mmdd, hhmm string) {
+	t = t.In(FedwireLocation)
+	return t.Format("0102"), t.Format("1504")
+}
+
+// ReceiptTimestamp returns the time the Fedwire Funds Service received the message from its
+// ReceiptTimeStamp, using the InputCycleDate of the IMAD to infer the year
+func (fwm *FEDWireMessage) ReceiptTimestamp() (time.Time, error) {
+	if fwm.ReceiptTimeStamp == nil {
+		return time.Time{}, fieldError("ReceiptTimeStamp", ErrFieldRequired)
+	}
+	if fwm.InputMessageAccountabilityData == nil {
+		return time.Time{}, fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	}
+	cycleDate, err := fwm.InputMessageAccountabilityData.CycleDate()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return fwm.ReceiptTimeStamp.Timestamp(cycleDate)
+}
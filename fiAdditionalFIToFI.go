@@ -136,25 +136,30 @@ func (fifi *FIAdditionalFIToFI) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on FIAdditionalFIToFI and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (fifi *FIAdditionalFIToFI) Validate() error {
+	return fifi.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on FIAdditionalFIToFI following opts, which may be nil
+func (fifi *FIAdditionalFIToFI) validate(opts *ValidateOpts) error {
 	if fifi.tag != TagFIAdditionalFIToFI {
 		return fieldError("tag", ErrValidTagForType, fifi.tag)
 	}
-	if err := fifi.isAlphanumeric(fifi.AdditionalFIToFI.LineOne); err != nil {
+	if err := fifi.isAlphanumeric(opts, fifi.AdditionalFIToFI.LineOne); err != nil {
 		return fieldError("LineOne", err, fifi.AdditionalFIToFI.LineOne)
 	}
-	if err := fifi.isAlphanumeric(fifi.AdditionalFIToFI.LineTwo); err != nil {
+	if err := fifi.isAlphanumeric(opts, fifi.AdditionalFIToFI.LineTwo); err != nil {
 		return fieldError("LineTwo", err, fifi.AdditionalFIToFI.LineTwo)
 	}
-	if err := fifi.isAlphanumeric(fifi.AdditionalFIToFI.LineThree); err != nil {
+	if err := fifi.isAlphanumeric(opts, fifi.AdditionalFIToFI.LineThree); err != nil {
 		return fieldError("LineThree", err, fifi.AdditionalFIToFI.LineThree)
 	}
-	if err := fifi.isAlphanumeric(fifi.AdditionalFIToFI.LineFour); err != nil {
+	if err := fifi.isAlphanumeric(opts, fifi.AdditionalFIToFI.LineFour); err != nil {
 		return fieldError("LineFour", err, fifi.AdditionalFIToFI.LineFour)
 	}
-	if err := fifi.isAlphanumeric(fifi.AdditionalFIToFI.LineFive); err != nil {
+	if err := fifi.isAlphanumeric(opts, fifi.AdditionalFIToFI.LineFive); err != nil {
 		return fieldError("LineFive", err, fifi.AdditionalFIToFI.LineFive)
 	}
-	if err := fifi.isAlphanumeric(fifi.AdditionalFIToFI.LineSix); err != nil {
+	if err := fifi.isAlphanumeric(opts, fifi.AdditionalFIToFI.LineSix); err != nil {
 		return fieldError("LineSix", err, fifi.AdditionalFIToFI.LineSix)
 	}
 	return nil
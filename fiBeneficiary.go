@@ -136,25 +136,30 @@ func (fib *FIBeneficiary) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on FIBeneficiary and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (fib *FIBeneficiary) Validate() error {
+	return fib.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on FIBeneficiary following opts, which may be nil
+func (fib *FIBeneficiary) validate(opts *ValidateOpts) error {
 	if fib.tag != TagFIBeneficiary {
 		return fieldError("tag", ErrValidTagForType, fib.tag)
 	}
-	if err := fib.isAlphanumeric(fib.FIToFI.LineOne); err != nil {
+	if err := fib.isAlphanumeric(opts, fib.FIToFI.LineOne); err != nil {
 		return fieldError("LineOne", err, fib.FIToFI.LineOne)
 	}
-	if err := fib.isAlphanumeric(fib.FIToFI.LineTwo); err != nil {
+	if err := fib.isAlphanumeric(opts, fib.FIToFI.LineTwo); err != nil {
 		return fieldError("LineTwo", err, fib.FIToFI.LineTwo)
 	}
-	if err := fib.isAlphanumeric(fib.FIToFI.LineThree); err != nil {
+	if err := fib.isAlphanumeric(opts, fib.FIToFI.LineThree); err != nil {
 		return fieldError("LineThree", err, fib.FIToFI.LineThree)
 	}
-	if err := fib.isAlphanumeric(fib.FIToFI.LineFour); err != nil {
+	if err := fib.isAlphanumeric(opts, fib.FIToFI.LineFour); err != nil {
 		return fieldError("LineFour", err, fib.FIToFI.LineFour)
 	}
-	if err := fib.isAlphanumeric(fib.FIToFI.LineFive); err != nil {
+	if err := fib.isAlphanumeric(opts, fib.FIToFI.LineFive); err != nil {
 		return fieldError("LineFive", err, fib.FIToFI.LineFive)
 	}
-	if err := fib.isAlphanumeric(fib.FIToFI.LineSix); err != nil {
+	if err := fib.isAlphanumeric(opts, fib.FIToFI.LineSix); err != nil {
 		return fieldError("LineSix", err, fib.FIToFI.LineSix)
 	}
 	return nil
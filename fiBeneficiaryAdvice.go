@@ -138,28 +138,33 @@ func (fiba *FIBeneficiaryAdvice) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on FIBeneficiaryAdvice and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (fiba *FIBeneficiaryAdvice) Validate() error {
+	return fiba.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on FIBeneficiaryAdvice following opts, which may be nil
+func (fiba *FIBeneficiaryAdvice) validate(opts *ValidateOpts) error {
 	if fiba.tag != TagFIBeneficiaryAdvice {
 		return fieldError("tag", ErrValidTagForType, fiba.tag)
 	}
 	if err := fiba.isAdviceCode(fiba.Advice.AdviceCode); err != nil {
 		return fieldError("AdviceCode", err, fiba.Advice.AdviceCode)
 	}
-	if err := fiba.isAlphanumeric(fiba.Advice.LineOne); err != nil {
+	if err := fiba.isAlphanumeric(opts, fiba.Advice.LineOne); err != nil {
 		return fieldError("LineOne", err, fiba.Advice.LineOne)
 	}
-	if err := fiba.isAlphanumeric(fiba.Advice.LineTwo); err != nil {
+	if err := fiba.isAlphanumeric(opts, fiba.Advice.LineTwo); err != nil {
 		return fieldError("LineTwo", err, fiba.Advice.LineTwo)
 	}
-	if err := fiba.isAlphanumeric(fiba.Advice.LineThree); err != nil {
+	if err := fiba.isAlphanumeric(opts, fiba.Advice.LineThree); err != nil {
 		return fieldError("LineThree", err, fiba.Advice.LineThree)
 	}
-	if err := fiba.isAlphanumeric(fiba.Advice.LineFour); err != nil {
+	if err := fiba.isAlphanumeric(opts, fiba.Advice.LineFour); err != nil {
 		return fieldError("LineFour", err, fiba.Advice.LineFour)
 	}
-	if err := fiba.isAlphanumeric(fiba.Advice.LineFive); err != nil {
+	if err := fiba.isAlphanumeric(opts, fiba.Advice.LineFive); err != nil {
 		return fieldError("LineFive", err, fiba.Advice.LineFive)
 	}
-	if err := fiba.isAlphanumeric(fiba.Advice.LineSix); err != nil {
+	if err := fiba.isAlphanumeric(opts, fiba.Advice.LineSix); err != nil {
 		return fieldError("LineSix", err, fiba.Advice.LineSix)
 	}
 	return nil
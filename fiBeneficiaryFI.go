@@ -136,25 +136,30 @@ func (fibfi *FIBeneficiaryFI) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on FIBeneficiaryFI and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (fibfi *FIBeneficiaryFI) Validate() error {
+	return fibfi.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on FIBeneficiaryFI following opts, which may be nil
+func (fibfi *FIBeneficiaryFI) validate(opts *ValidateOpts) error {
 	if fibfi.tag != TagFIBeneficiaryFI {
 		return fieldError("tag", ErrValidTagForType, fibfi.tag)
 	}
-	if err := fibfi.isAlphanumeric(fibfi.FIToFI.LineOne); err != nil {
+	if err := fibfi.isAlphanumeric(opts, fibfi.FIToFI.LineOne); err != nil {
 		return fieldError("LineOne", err, fibfi.FIToFI.LineOne)
 	}
-	if err := fibfi.isAlphanumeric(fibfi.FIToFI.LineTwo); err != nil {
+	if err := fibfi.isAlphanumeric(opts, fibfi.FIToFI.LineTwo); err != nil {
 		return fieldError("LineTwo", err, fibfi.FIToFI.LineTwo)
 	}
-	if err := fibfi.isAlphanumeric(fibfi.FIToFI.LineThree); err != nil {
+	if err := fibfi.isAlphanumeric(opts, fibfi.FIToFI.LineThree); err != nil {
 		return fieldError("LineThree", err, fibfi.FIToFI.LineThree)
 	}
-	if err := fibfi.isAlphanumeric(fibfi.FIToFI.LineFour); err != nil {
+	if err := fibfi.isAlphanumeric(opts, fibfi.FIToFI.LineFour); err != nil {
 		return fieldError("LineFour", err, fibfi.FIToFI.LineFour)
 	}
-	if err := fibfi.isAlphanumeric(fibfi.FIToFI.LineFive); err != nil {
+	if err := fibfi.isAlphanumeric(opts, fibfi.FIToFI.LineFive); err != nil {
 		return fieldError("LineFive", err, fibfi.FIToFI.LineFive)
 	}
-	if err := fibfi.isAlphanumeric(fibfi.FIToFI.LineSix); err != nil {
+	if err := fibfi.isAlphanumeric(opts, fibfi.FIToFI.LineSix); err != nil {
 		return fieldError("LineSix", err, fibfi.FIToFI.LineSix)
 	}
 	return nil
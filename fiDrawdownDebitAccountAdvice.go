@@ -138,28 +138,33 @@ func (debitDDAdvice *FIDrawdownDebitAccountAdvice) Format(options FormatOptions)
 // Validate performs WIRE format rule checks on FIDrawdownDebitAccountAdvice and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (debitDDAdvice *FIDrawdownDebitAccountAdvice) Validate() error {
+	return debitDDAdvice.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on FIDrawdownDebitAccountAdvice following opts, which may be nil
+func (debitDDAdvice *FIDrawdownDebitAccountAdvice) validate(opts *ValidateOpts) error {
 	if debitDDAdvice.tag != TagFIDrawdownDebitAccountAdvice {
 		return fieldError("tag", ErrValidTagForType, debitDDAdvice.tag)
 	}
 	if err := debitDDAdvice.isAdviceCode(debitDDAdvice.Advice.AdviceCode); err != nil {
 		return fieldError("AdviceCode", err, debitDDAdvice.Advice.AdviceCode)
 	}
-	if err := debitDDAdvice.isAlphanumeric(debitDDAdvice.Advice.LineOne); err != nil {
+	if err := debitDDAdvice.isAlphanumeric(opts, debitDDAdvice.Advice.LineOne); err != nil {
 		return fieldError("LineOne", err, debitDDAdvice.Advice.LineOne)
 	}
-	if err := debitDDAdvice.isAlphanumeric(debitDDAdvice.Advice.LineTwo); err != nil {
+	if err := debitDDAdvice.isAlphanumeric(opts, debitDDAdvice.Advice.LineTwo); err != nil {
 		return fieldError("LineTwo", err, debitDDAdvice.Advice.LineTwo)
 	}
-	if err := debitDDAdvice.isAlphanumeric(debitDDAdvice.Advice.LineThree); err != nil {
+	if err := debitDDAdvice.isAlphanumeric(opts, debitDDAdvice.Advice.LineThree); err != nil {
 		return fieldError("LineThree", err, debitDDAdvice.Advice.LineThree)
 	}
-	if err := debitDDAdvice.isAlphanumeric(debitDDAdvice.Advice.LineFour); err != nil {
+	if err := debitDDAdvice.isAlphanumeric(opts, debitDDAdvice.Advice.LineFour); err != nil {
 		return fieldError("LineFour", err, debitDDAdvice.Advice.LineFour)
 	}
-	if err := debitDDAdvice.isAlphanumeric(debitDDAdvice.Advice.LineFive); err != nil {
+	if err := debitDDAdvice.isAlphanumeric(opts, debitDDAdvice.Advice.LineFive); err != nil {
 		return fieldError("LineFive", err, debitDDAdvice.Advice.LineFive)
 	}
-	if err := debitDDAdvice.isAlphanumeric(debitDDAdvice.Advice.LineSix); err != nil {
+	if err := debitDDAdvice.isAlphanumeric(opts, debitDDAdvice.Advice.LineSix); err != nil {
 		return fieldError("LineSix", err, debitDDAdvice.Advice.LineSix)
 	}
 	return nil
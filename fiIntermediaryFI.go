@@ -136,25 +136,30 @@ func (fiifi *FIIntermediaryFI) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on FIIntermediaryFI and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (fiifi *FIIntermediaryFI) Validate() error {
+	return fiifi.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on FIIntermediaryFI following opts, which may be nil
+func (fiifi *FIIntermediaryFI) validate(opts *ValidateOpts) error {
 	if fiifi.tag != TagFIIntermediaryFI {
 		return fieldError("tag", ErrValidTagForType, fiifi.tag)
 	}
-	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineOne); err != nil {
+	if err := fiifi.isAlphanumeric(opts, fiifi.FIToFI.LineOne); err != nil {
 		return fieldError("LineOne", err, fiifi.FIToFI.LineOne)
 	}
-	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineTwo); err != nil {
+	if err := fiifi.isAlphanumeric(opts, fiifi.FIToFI.LineTwo); err != nil {
 		return fieldError("LineTwo", err, fiifi.FIToFI.LineTwo)
 	}
-	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineThree); err != nil {
+	if err := fiifi.isAlphanumeric(opts, fiifi.FIToFI.LineThree); err != nil {
 		return fieldError("LineThree", err, fiifi.FIToFI.LineThree)
 	}
-	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineFour); err != nil {
+	if err := fiifi.isAlphanumeric(opts, fiifi.FIToFI.LineFour); err != nil {
 		return fieldError("LineFour", err, fiifi.FIToFI.LineFour)
 	}
-	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineFive); err != nil {
+	if err := fiifi.isAlphanumeric(opts, fiifi.FIToFI.LineFive); err != nil {
 		return fieldError("LineFive", err, fiifi.FIToFI.LineFive)
 	}
-	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineSix); err != nil {
+	if err := fiifi.isAlphanumeric(opts, fiifi.FIToFI.LineSix); err != nil {
 		return fieldError("LineSix", err, fiifi.FIToFI.LineSix)
 	}
 	return nil
@@ -138,28 +138,33 @@ func (fiifia *FIIntermediaryFIAdvice) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on FIIntermediaryFIAdvice and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (fiifia *FIIntermediaryFIAdvice) Validate() error {
+	return fiifia.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on FIIntermediaryFIAdvice following opts, which may be nil
+func (fiifia *FIIntermediaryFIAdvice) validate(opts *ValidateOpts) error {
 	if fiifia.tag != TagFIIntermediaryFIAdvice {
 		return fieldError("tag", ErrValidTagForType, fiifia.tag)
 	}
 	if err := fiifia.isAdviceCode(fiifia.Advice.AdviceCode); err != nil {
 		return fieldError("AdviceCode", err, fiifia.Advice.AdviceCode)
 	}
-	if err := fiifia.isAlphanumeric(fiifia.Advice.LineOne); err != nil {
+	if err := fiifia.isAlphanumeric(opts, fiifia.Advice.LineOne); err != nil {
 		return fieldError("LineOne", err, fiifia.Advice.LineOne)
 	}
-	if err := fiifia.isAlphanumeric(fiifia.Advice.LineTwo); err != nil {
+	if err := fiifia.isAlphanumeric(opts, fiifia.Advice.LineTwo); err != nil {
 		return fieldError("LineTwo", err, fiifia.Advice.LineTwo)
 	}
-	if err := fiifia.isAlphanumeric(fiifia.Advice.LineThree); err != nil {
+	if err := fiifia.isAlphanumeric(opts, fiifia.Advice.LineThree); err != nil {
 		return fieldError("LineThree", err, fiifia.Advice.LineThree)
 	}
-	if err := fiifia.isAlphanumeric(fiifia.Advice.LineFour); err != nil {
+	if err := fiifia.isAlphanumeric(opts, fiifia.Advice.LineFour); err != nil {
 		return fieldError("LineFour", err, fiifia.Advice.LineFour)
 	}
-	if err := fiifia.isAlphanumeric(fiifia.Advice.LineFive); err != nil {
+	if err := fiifia.isAlphanumeric(opts, fiifia.Advice.LineFive); err != nil {
 		return fieldError("LineFive", err, fiifia.Advice.LineFive)
 	}
-	if err := fiifia.isAlphanumeric(fiifia.Advice.LineSix); err != nil {
+	if err := fiifia.isAlphanumeric(opts, fiifia.Advice.LineSix); err != nil {
 		return fieldError("LineSix", err, fiifia.Advice.LineSix)
 	}
 	return nil
@@ -97,13 +97,18 @@ func (pm *FIPaymentMethodToBeneficiary) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on FIPaymentMethodToBeneficiary and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (pm *FIPaymentMethodToBeneficiary) Validate() error {
+	return pm.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on FIPaymentMethodToBeneficiary following opts, which may be nil
+func (pm *FIPaymentMethodToBeneficiary) validate(opts *ValidateOpts) error {
 	if err := pm.fieldInclusion(); err != nil {
 		return err
 	}
 	if pm.tag != TagFIPaymentMethodToBeneficiary {
 		return fieldError("tag", ErrValidTagForType, pm.tag)
 	}
-	if err := pm.isAlphanumeric(pm.AdditionalInformation); err != nil {
+	if err := pm.isAlphanumeric(opts, pm.AdditionalInformation); err != nil {
 		return fieldError("AdditionalInformation", err, pm.AdditionalInformation)
 	}
 	return nil
@@ -136,25 +136,30 @@ func (firfi *FIReceiverFI) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on FIReceiverFI and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (firfi *FIReceiverFI) Validate() error {
+	return firfi.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on FIReceiverFI following opts, which may be nil
+func (firfi *FIReceiverFI) validate(opts *ValidateOpts) error {
 	if firfi.tag != TagFIReceiverFI {
 		return fieldError("tag", ErrValidTagForType, firfi.tag)
 	}
-	if err := firfi.isAlphanumeric(firfi.FIToFI.LineOne); err != nil {
+	if err := firfi.isAlphanumeric(opts, firfi.FIToFI.LineOne); err != nil {
 		return fieldError("LineOne", err, firfi.FIToFI.LineOne)
 	}
-	if err := firfi.isAlphanumeric(firfi.FIToFI.LineTwo); err != nil {
+	if err := firfi.isAlphanumeric(opts, firfi.FIToFI.LineTwo); err != nil {
 		return fieldError("LineTwo", err, firfi.FIToFI.LineTwo)
 	}
-	if err := firfi.isAlphanumeric(firfi.FIToFI.LineThree); err != nil {
+	if err := firfi.isAlphanumeric(opts, firfi.FIToFI.LineThree); err != nil {
 		return fieldError("LineThree", err, firfi.FIToFI.LineThree)
 	}
-	if err := firfi.isAlphanumeric(firfi.FIToFI.LineFour); err != nil {
+	if err := firfi.isAlphanumeric(opts, firfi.FIToFI.LineFour); err != nil {
 		return fieldError("LineFour", err, firfi.FIToFI.LineFour)
 	}
-	if err := firfi.isAlphanumeric(firfi.FIToFI.LineFive); err != nil {
+	if err := firfi.isAlphanumeric(opts, firfi.FIToFI.LineFive); err != nil {
 		return fieldError("LineFive", err, firfi.FIToFI.LineFive)
 	}
-	if err := firfi.isAlphanumeric(firfi.FIToFI.LineSix); err != nil {
+	if err := firfi.isAlphanumeric(opts, firfi.FIToFI.LineSix); err != nil {
 		return fieldError("LineSix", err, firfi.FIToFI.LineSix)
 	}
 	return nil
@@ -7,8 +7,10 @@ package wire
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
+// The stable code of each error, such as WIRE-3600-002, is returned by ErrorCode
 var (
 	// Errors specific to validation
 
@@ -22,6 +24,8 @@ var (
 	ErrNonAmount = errors.New("is an incorrect amount format")
 	// ErrNonCurrencyCode is returned for an incorrect currency code
 	ErrNonCurrencyCode = errors.New("is not a recognized currency code")
+	// ErrNonCountryCode is returned for an incorrect country code
+	ErrNonCountryCode = errors.New("is not a recognized country code")
 	// ErrUpperAlpha is returned when a field is not in uppercase
 	ErrUpperAlpha = errors.New("is not uppercase A-Z or 0-9")
 	// ErrFieldInclusion is returned when a field is mandatory and has a default value
@@ -61,6 +65,13 @@ var (
 	// ErrSubTypeCode is returned when there's an invalid SubTypeCode tag
 	ErrSubTypeCode = errors.New("is an invalid sub type Code")
 
+	// InputMessageAccountabilityData Tag {1520}
+
+	// ErrIMADCycleDate is returned when an IMAD is requested for a cycle date before the last one of its input source
+	ErrIMADCycleDate = errors.New("is before the last cycle date of the input source")
+	// ErrIMADSequenceExhausted is returned when every sequence number of a cycle date has been allocated
+	ErrIMADSequenceExhausted = errors.New("has no sequence numbers left for the input source")
+
 	// BusinessFunctionCode Tag {3600}
 
 	// ErrBusinessFunctionCode is returned for an invalid business function code
@@ -123,6 +134,15 @@ var (
 	// ErrOptionFName is returned for an invalid name for OriginatorOptionF
 	ErrOptionFName = errors.New("is an invalid name for originator optionF")
 
+	// ErrABANumber is returned for an ABA routing number which isn't nine digits or has the wrong check digit
+	ErrABANumber = errors.New("is an invalid ABA routing number")
+
+	// ErrBIC is returned for a SWIFT BIC which doesn't have the ISO 9362 structure or has an unknown country code
+	ErrBIC = errors.New("is an invalid SWIFT BIC")
+
+	// ErrIBAN is returned for an IBAN with the wrong ISO 13616 check digits
+	ErrIBAN = errors.New("is an invalid IBAN")
+
 	// ErrValidLength is returned for an field with invalid length
 	ErrValidLength = errors.New("is an invalid length")
 
@@ -136,14 +156,20 @@ type FieldError struct {
 	Value     interface{} // value that cause error
 	Err       error       // context of the error.
 	Msg       string      // deprecated
+	Tag       string      // tag number holding the field, such as {3600}. Set by ValidateAll
+	Path      string      // path of the field within the FEDWireMessage. Set by ValidateAll
 }
 
 // Error message is constructed
 func (e *FieldError) Error() string {
+	field := e.FieldName
+	if e.Path != "" {
+		field = strings.TrimSpace(e.Tag + " " + e.Path)
+	}
 	if e.Value != nil {
-		return fmt.Sprintf("%s %v %s", e.FieldName, e.Value, e.Err)
+		return fmt.Sprintf("%s %v %s", field, e.Value, e.Err)
 	} else {
-		return fmt.Sprintf("%s %s", e.FieldName, e.Err)
+		return fmt.Sprintf("%s %s", field, e.Err)
 	}
 }
 
@@ -152,6 +178,11 @@ func (e *FieldError) Unwrap() error {
 	return e.Err
 }
 
+// Code returns the code of the error found in the field, such as WIRE-0000-009 for ErrFieldRequired
+func (e *FieldError) Code() string {
+	return ErrorCode(e.Err)
+}
+
 func fieldError(field string, err error, values ...interface{}) error {
 	if err == nil {
 		return nil
@@ -170,6 +201,31 @@ func fieldError(field string, err error, values ...interface{}) error {
 	return &fe
 }
 
+// newMessageFieldError returns err as a *FieldError with the tag number and field path set. field is
+// the FEDWireMessage field the error was found in and is used when err doesn't name one.
+func newMessageFieldError(field string, err error) *FieldError {
+	fe := &FieldError{FieldName: field, Err: err}
+	if e, ok := err.(*FieldError); ok {
+		copied := *e
+		fe = &copied
+	}
+
+	fe.Path = fe.FieldName
+	if _, ok := fieldTags[pathRoot(fe.FieldName)]; !ok && fe.FieldName != field {
+		fe.Path = field + "." + fe.FieldName
+	}
+	fe.Tag = fieldTags[pathRoot(fe.Path)]
+	return fe
+}
+
+// pathRoot returns the first element of a field path such as BusinessFunctionCode.TransactionTypeCode
+func pathRoot(path string) string {
+	if i := strings.IndexAny(path, ". "); i > 0 {
+		return path[:i]
+	}
+	return path
+}
+
 // ErrBusinessFunctionCodeProperty is the error given when the observed check digit does not match the calculated one
 type ErrBusinessFunctionCodeProperty struct {
 	Message              string
@@ -192,6 +248,11 @@ func (e ErrBusinessFunctionCodeProperty) Error() string {
 	return e.Message
 }
 
+// Code returns WIRE-3600-003
+func (e ErrBusinessFunctionCodeProperty) Code() string {
+	return "WIRE-3600-003"
+}
+
 // ErrInvalidPropertyForProperty is the error given when the observed check digit does not match the calculated one
 type ErrInvalidPropertyForProperty struct {
 	Message             string
@@ -216,6 +277,11 @@ func (e ErrInvalidPropertyForProperty) Error() string {
 	return e.Message
 }
 
+// Code returns WIRE-0000-019
+func (e ErrInvalidPropertyForProperty) Code() string {
+	return "WIRE-0000-019"
+}
+
 // FieldWrongLengthErr is the error given when a Field is the wrong length
 type FieldWrongLengthErr struct {
 	Message     string
@@ -235,3 +301,8 @@ func NewFieldWrongLengthErr(FieldLength, length int) FieldWrongLengthErr {
 func (e FieldWrongLengthErr) Error() string {
 	return e.Message
 }
+
+// Code returns WIRE-0000-020
+func (e FieldWrongLengthErr) Code() string {
+	return "WIRE-0000-020"
+}
@@ -7,13 +7,24 @@ package wire
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+
+	"github.com/moov-io/base"
 )
 
 // File contains the structures of a parsed WIRE File.
+//
+// A File holds one or more FEDWireMessages in the order they were read. FEDWireMessage is the
+// first message and AdditionalFEDWireMessages holds any that follow it, so callers working with
+// single-message files can keep using FEDWireMessage directly.
 type File struct {
 	ID             string         `json:"id"`
 	FEDWireMessage FEDWireMessage `json:"fedWireMessage"`
+	// AdditionalFEDWireMessages are the messages following FEDWireMessage in a multi-message file
+	AdditionalFEDWireMessages []FEDWireMessage `json:"additionalFedWireMessages,omitempty"`
+	// SourceFormatOptions is the format the File was in when read by Reader, or nil if it wasn't read
+	SourceFormatOptions *FormatOptions `json:"-"`
 }
 
 // NewFile constructs a file template
@@ -27,12 +38,14 @@ func NewFile(opts ...FilePropertyFunc) *File {
 	return f
 }
 
-// SetValidation stores ValidateOpts on the FEDWireMessage's validation rules
+// SetValidation stores ValidateOpts on the validation rules of every FEDWireMessage in the File
 func (f *File) SetValidation(opts *ValidateOpts) {
 	if f == nil || opts == nil {
 		return
 	}
-	f.FEDWireMessage.ValidateOptions = opts
+	for _, fwm := range f.Messages() {
+		fwm.ValidateOptions = opts
+	}
 }
 
 // GetValidation returns validation rules of FEDWireMessage
@@ -44,9 +57,31 @@ func (f *File) GetValidation() *ValidateOpts {
 }
 
 // AddFEDWireMessage appends a FEDWireMessage to the File
+//
+// The first message added is stored in FEDWireMessage, later messages are appended to
+// AdditionalFEDWireMessages.
 func (f *File) AddFEDWireMessage(fwm FEDWireMessage) FEDWireMessage {
-	f.FEDWireMessage = fwm
-	return f.FEDWireMessage
+	if f.FEDWireMessage.isEmpty() {
+		f.FEDWireMessage = fwm
+		return f.FEDWireMessage
+	}
+	f.AdditionalFEDWireMessages = append(f.AdditionalFEDWireMessages, fwm)
+	return fwm
+}
+
+// Messages returns every FEDWireMessage in the File in order, starting with FEDWireMessage.
+//
+// The returned pointers refer to the File's own messages so changes made through them are kept.
+func (f *File) Messages() []*FEDWireMessage {
+	if f == nil {
+		return nil
+	}
+	messages := make([]*FEDWireMessage, 0, 1+len(f.AdditionalFEDWireMessages))
+	messages = append(messages, &f.FEDWireMessage)
+	for i := range f.AdditionalFEDWireMessages {
+		messages = append(messages, &f.AdditionalFEDWireMessages[i])
+	}
+	return messages
 }
 
 // Create will tabulate and assemble an WIRE file into a valid state.
@@ -58,11 +93,50 @@ func (f *File) Create() error {
 }
 
 // Validate will never modify the file.
+//
+// Files with more than one FEDWireMessage return a base.ErrorList holding a *MessageError for
+// each message that failed validation.
 func (f *File) Validate() error {
-	if err := f.FEDWireMessage.verify(); err != nil {
-		return err
+	if len(f.AdditionalFEDWireMessages) == 0 {
+		if err := f.FEDWireMessage.verify(); err != nil {
+			return err
+		}
+		return nil
 	}
-	return nil
+
+	var errs base.ErrorList
+	for i, fwm := range f.Messages() {
+		if err := fwm.verify(); err != nil {
+			errs.Add(&MessageError{Index: i, Err: err})
+		}
+	}
+	if errs.Empty() {
+		return nil
+	}
+	return errs
+}
+
+// ValidateAll is like Validate but returns every error found rather than the first. See FEDWireMessage.ValidateAll.
+//
+// Errors in files with more than one FEDWireMessage are wrapped in a *MessageError naming the message.
+func (f *File) ValidateAll() error {
+	if len(f.AdditionalFEDWireMessages) == 0 {
+		return f.FEDWireMessage.ValidateAll()
+	}
+
+	var errs base.ErrorList
+	for i, fwm := range f.Messages() {
+		var el base.ErrorList
+		if errors.As(fwm.ValidateAll(), &el) {
+			for _, err := range el {
+				errs.Add(&MessageError{Index: i, Err: err})
+			}
+		}
+	}
+	if errs.Empty() {
+		return nil
+	}
+	return errs
 }
 
 // FileFromJSON attempts to return a *File object assuming the input is valid JSON.
@@ -12,8 +12,48 @@ import (
 var (
 	// ErrFileTooLong is the error given when a file exceeds the maximum possible length
 	ErrFileTooLong = errors.New("file exceeds maximum possible number of lines")
+	// ErrFileTooLarge is the error given when a file exceeds the maximum number of bytes which may be read
+	ErrFileTooLarge = errors.New("file exceeds maximum possible size")
+	// ErrTagOrderUnknown is the error given when ValidateOpts.CheckTagOrder is set on a message whose tags
+	// weren't read by Reader, so the order they were in is unknown
+	ErrTagOrderUnknown = errors.New("tag order is unknown as the message wasn't read by Reader")
 )
 
+// FileTooLongErr is the error given when the input exceeds a limit set in ReaderOpts
+type FileTooLongErr struct {
+	Message string
+	Limit   string // name of the ReaderOpts field which was exceeded, such as MaxBytes
+	Max     int64  // value of the limit
+}
+
+// NewFileTooLongErr creates a new error of the FileTooLongErr type
+func NewFileTooLongErr(limit string, max int64) FileTooLongErr {
+	e := FileTooLongErr{
+		Limit: limit,
+		Max:   max,
+	}
+	e.Message = fmt.Sprintf("%v: %s of %d exceeded", e.Unwrap(), limit, max)
+	return e
+}
+
+func (e FileTooLongErr) Error() string {
+	return e.Message
+}
+
+// Unwrap returns ErrFileTooLarge when MaxBytes was exceeded and ErrFileTooLong for the other limits,
+// so errors.Is can be used to check which kind of limit was exceeded
+func (e FileTooLongErr) Unwrap() error {
+	if e.Limit == "MaxBytes" {
+		return ErrFileTooLarge
+	}
+	return ErrFileTooLong
+}
+
+// Code returns WIRE-FILE-001, the code of ErrFileTooLong, or WIRE-FILE-007, the code of ErrFileTooLarge
+func (e FileTooLongErr) Code() string {
+	return errorCodes[e.Unwrap()]
+}
+
 // TagWrongLengthErr is the error given when a Tag is the wrong length
 type TagWrongLengthErr struct {
 	Message   string
@@ -50,6 +90,36 @@ func (e TagWrongLengthErr) Error() string {
 	return e.Message
 }
 
+// Code returns WIRE-FILE-002
+func (e TagWrongLengthErr) Code() string {
+	return "WIRE-FILE-002"
+}
+
+// TagTooLongErr is the error given when a tag is longer than ReaderOpts.MaxTagLength
+type TagTooLongErr struct {
+	Message   string
+	MaxLength int
+	Length    int
+}
+
+// NewTagTooLongErr creates a new error of the TagTooLongErr type
+func NewTagTooLongErr(maxLength, length int) TagTooLongErr {
+	return TagTooLongErr{
+		Message:   fmt.Sprintf("must be maximum %d characters and found %d", maxLength, length),
+		MaxLength: maxLength,
+		Length:    length,
+	}
+}
+
+func (e TagTooLongErr) Error() string {
+	return e.Message
+}
+
+// Code returns WIRE-FILE-008
+func (e TagTooLongErr) Code() string {
+	return "WIRE-FILE-008"
+}
+
 // ErrInvalidTag is the error given when a tag is invalid
 type ErrInvalidTag struct {
 	Message string
@@ -67,3 +137,77 @@ func NewErrInvalidTag(tag string) ErrInvalidTag {
 func (e ErrInvalidTag) Error() string {
 	return e.Message
 }
+
+// Code returns WIRE-FILE-003
+func (e ErrInvalidTag) Code() string {
+	return "WIRE-FILE-003"
+}
+
+// ErrDuplicateTag is the error given when a tag appears more than once within a FEDWireMessage
+type ErrDuplicateTag struct {
+	Message   string
+	Type      string
+	FirstLine int // line of the first occurrence
+	Line      int // line of the repeated occurrence
+}
+
+// NewErrDuplicateTag creates a new error of the ErrDuplicateTag type
+func NewErrDuplicateTag(tag string, firstLine, line int) ErrDuplicateTag {
+	return ErrDuplicateTag{
+		Message:   fmt.Sprintf("%s is duplicated on lines %d and %d", tag, firstLine, line),
+		Type:      tag,
+		FirstLine: firstLine,
+		Line:      line,
+	}
+}
+
+func (e ErrDuplicateTag) Error() string {
+	return e.Message
+}
+
+// Code returns WIRE-FILE-004
+func (e ErrDuplicateTag) Code() string {
+	return "WIRE-FILE-004"
+}
+
+// ErrTagOrder is the error given when a tag is found after a tag of its group which it should precede
+type ErrTagOrder struct {
+	Message  string
+	Type     string
+	Previous string // the tag which should follow Type
+	Position int    // position of the tag within its FEDWireMessage, starting at 1
+}
+
+// NewErrTagOrder creates a new error of the ErrTagOrder type
+func NewErrTagOrder(tag, previous string, position int) ErrTagOrder {
+	return ErrTagOrder{
+		Message:  fmt.Sprintf("%s at position %d is out of order, it must precede %s", tag, position, previous),
+		Type:     tag,
+		Previous: previous,
+		Position: position,
+	}
+}
+
+func (e ErrTagOrder) Error() string {
+	return e.Message
+}
+
+// Code returns WIRE-FILE-005
+func (e ErrTagOrder) Code() string {
+	return "WIRE-FILE-005"
+}
+
+// MessageError is the error given when a FEDWireMessage within a multi-message File is invalid
+type MessageError struct {
+	Index int   // position of the message within the File, starting at 0
+	Err   error // the error found in the message
+}
+
+func (e *MessageError) Error() string {
+	return fmt.Sprintf("message %d: %v", e.Index+1, e.Err)
+}
+
+// Unwrap implements the base.UnwrappableError interface for MessageError
+func (e *MessageError) Unwrap() error {
+	return e.Err
+}
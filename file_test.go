@@ -5,10 +5,14 @@
 package wire
 
 import (
+	"encoding/json"
+	"errors"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/moov-io/base"
 	"github.com/stretchr/testify/require"
 )
 
@@ -27,3 +31,84 @@ func TestFile__FileFromJSON(t *testing.T) {
 	require.Empty(t, file.ID, "id should not have been set")
 	require.NotNil(t, file.FEDWireMessage.FIAdditionalFIToFI, "FIAdditionalFIToFI shouldn't be nil")
 }
+
+func TestFile__AddFEDWireMessage(t *testing.T) {
+	file := NewFile()
+
+	first := mockCustomerTransferData()
+	first.Beneficiary = mockBeneficiary()
+	first.Originator = mockOriginator()
+	file.AddFEDWireMessage(first)
+	require.Empty(t, file.AdditionalFEDWireMessages)
+	require.NoError(t, file.Validate())
+
+	second := mockCustomerTransferData()
+	file.AddFEDWireMessage(second)
+	require.Len(t, file.AdditionalFEDWireMessages, 1)
+	require.Len(t, file.Messages(), 2)
+
+	// the second message is missing its Beneficiary
+	err := file.Validate()
+	require.Error(t, err)
+
+	var msgErr *MessageError
+	require.ErrorAs(t, err.(base.ErrorList)[0], &msgErr)
+	require.Equal(t, 1, msgErr.Index)
+	require.ErrorIs(t, err.(base.ErrorList)[0], ErrFieldRequired)
+
+	// changes made through Messages are kept on the File
+	file.Messages()[1].Beneficiary = mockBeneficiary()
+	file.Messages()[1].Originator = mockOriginator()
+	require.NoError(t, file.Validate())
+}
+
+func TestFile__ValidateAll(t *testing.T) {
+	file := NewFile()
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	file.AddFEDWireMessage(fwm)
+	require.NoError(t, file.ValidateAll())
+
+	invalid := mockCustomerTransferData()
+	invalid.Amount.Amount = "00000000000A"
+	file.AddFEDWireMessage(invalid)
+
+	var errs base.ErrorList
+	require.ErrorAs(t, file.ValidateAll(), &errs)
+	require.Len(t, errs, 2)
+
+	var msgErr *MessageError
+	require.ErrorAs(t, errs[0], &msgErr)
+	require.Equal(t, 1, msgErr.Index)
+
+	var fe *FieldError
+	require.ErrorAs(t, errs[0], &fe)
+	require.Equal(t, TagAmount, fe.Tag)
+}
+
+// files holding one message return the errors of that message as they are
+func TestFile__singleMessageErrors(t *testing.T) {
+	file := NewFile()
+	fwm := mockCustomerTransferData()
+	file.AddFEDWireMessage(fwm)
+
+	err := file.Validate()
+	require.EqualError(t, err, "Beneficiary is a required field")
+	require.Equal(t, fwm.verify(), err)
+	require.False(t, errors.As(err, new(*MessageError)))
+
+	require.Equal(t, fwm.ValidateAll(), file.ValidateAll())
+
+	err = NewWriter(io.Discard).Write(file)
+	require.EqualError(t, err, "Beneficiary is a required field")
+	require.False(t, errors.As(err, new(*MessageError)))
+
+	// JSON keeps the single fedWireMessage object
+	bs, err := json.Marshal(file)
+	require.NoError(t, err)
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bs, &fields))
+	require.Contains(t, fields, "fedWireMessage")
+	require.NotContains(t, fields, "additionalFedWireMessages")
+}
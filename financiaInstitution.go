@@ -33,6 +33,11 @@ type FinancialInstitution struct {
 }
 
 func (fi FinancialInstitution) Validate() error {
+	return fi.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on FinancialInstitution following opts, which may be nil
+func (fi FinancialInstitution) validate(opts *ValidateOpts) error {
 	if err := fi.fieldInclusion(); err != nil {
 		return err
 	}
@@ -42,19 +47,27 @@ func (fi FinancialInstitution) Validate() error {
 		return fieldError("IdentificationCode", ErrIdentificationCode, fi.IdentificationCode)
 	}
 
-	if err := fi.isAlphanumeric(fi.Identifier); err != nil {
+	if err := fi.isAlphanumeric(opts, fi.Identifier); err != nil {
+		return fieldError("Identifier", err, fi.Identifier)
+	}
+	if fi.IdentificationCode == FEDRoutingNumber {
+		if err := fi.isABANumber(opts, fi.Identifier); err != nil {
+			return fieldError("Identifier", err, fi.Identifier)
+		}
+	}
+	if err := fi.validateAccountIdentifier(opts, fi.IdentificationCode, fi.Identifier); err != nil {
 		return fieldError("Identifier", err, fi.Identifier)
 	}
-	if err := fi.isAlphanumeric(fi.Name); err != nil {
+	if err := fi.isAlphanumeric(opts, fi.Name); err != nil {
 		return fieldError("Name", err, fi.Name)
 	}
-	if err := fi.isAlphanumeric(fi.Address.AddressLineOne); err != nil {
+	if err := fi.isAlphanumeric(opts, fi.Address.AddressLineOne); err != nil {
 		return fieldError("AddressLineOne", err, fi.Address.AddressLineOne)
 	}
-	if err := fi.isAlphanumeric(fi.Address.AddressLineTwo); err != nil {
+	if err := fi.isAlphanumeric(opts, fi.Address.AddressLineTwo); err != nil {
 		return fieldError("AddressLineTwo", err, fi.Address.AddressLineTwo)
 	}
-	if err := fi.isAlphanumeric(fi.Address.AddressLineThree); err != nil {
+	if err := fi.isAlphanumeric(opts, fi.Address.AddressLineThree); err != nil {
 		return fieldError("AddressLineThree", err, fi.Address.AddressLineThree)
 	}
 
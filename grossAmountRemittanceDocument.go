@@ -104,13 +104,18 @@ func (gard *GrossAmountRemittanceDocument) Format(options FormatOptions) string
 // Validate performs WIRE format rule checks on GrossAmountRemittanceDocument and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (gard *GrossAmountRemittanceDocument) Validate() error {
+	return gard.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on GrossAmountRemittanceDocument following opts, which may be nil
+func (gard *GrossAmountRemittanceDocument) validate(opts *ValidateOpts) error {
 	if err := gard.fieldInclusion(); err != nil {
 		return err
 	}
 	if gard.tag != TagGrossAmountRemittanceDocument {
 		return fieldError("tag", ErrValidTagForType, gard.tag)
 	}
-	if err := gard.isCurrencyCode(gard.RemittanceAmount.CurrencyCode); err != nil {
+	if err := gard.isCurrencyCode(opts, gard.RemittanceAmount.CurrencyCode); err != nil {
 		return fieldError("CurrencyCode", err, gard.RemittanceAmount.CurrencyCode)
 	}
 	if err := gard.isAmount(gard.RemittanceAmount.Amount); err != nil {
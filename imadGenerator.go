@@ -0,0 +1,185 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/moov-io/wire/calendar"
+)
+
+// maxIMADSequence is the largest six digit InputSequenceNumber
+const maxIMADSequence = 999999
+
+// IMADStore persists the sequence numbers allocated by an IMADGenerator. Implementations must never return
+// the same sequence number twice for an input source and cycle date, even after a restart.
+type IMADStore interface {
+	// NextSequence allocates and returns the next sequence number for the input source on cycleDate (CCYYMMDD),
+	// starting at 1. The sequence restarts when cycleDate is later than the last cycle date of the input source.
+	NextSequence(inputSource, cycleDate string) (int, error)
+}
+
+// IMADGenerator creates the {1520} InputMessageAccountabilityData of outgoing messages, allocating a
+// unique InputSequenceNumber for each message from its IMADStore
+type IMADGenerator struct {
+	inputSource string
+	store       IMADStore
+
+	// now returns the current time, which is replaced in tests
+	now func() time.Time
+}
+
+// NewIMADGenerator returns a new IMADGenerator for inputSource, the LTERM of the sender
+func NewIMADGenerator(inputSource string, store IMADStore) *IMADGenerator {
+	return &IMADGenerator{
+		inputSource: inputSource,
+		store:       store,
+		now:         time.Now,
+	}
+}
+
+// Next returns a new InputMessageAccountabilityData for the input cycle date of a message released now,
+// which skips weekends and Federal Reserve holidays
+func (g *IMADGenerator) Next() (*InputMessageAccountabilityData, error) {
+	return g.NextForCycleDate(calendar.CycleDate(g.now()))
+}
+
+// NextForCycleDate returns a new InputMessageAccountabilityData for cycleDate
+func (g *IMADGenerator) NextForCycleDate(cycleDate time.Time) (*InputMessageAccountabilityData, error) {
+	imad := NewInputMessageAccountabilityData()
+	imad.SetCycleDate(cycleDate)
+	imad.InputSource = g.inputSource
+	imad.InputSequenceNumber = "000000"
+	if err := imad.Validate(); err != nil {
+		return nil, err
+	}
+
+	sequence, err := g.store.NextSequence(imad.InputSource, imad.InputCycleDate)
+	if err != nil {
+		return nil, err
+	}
+	imad.InputSequenceNumber = fmt.Sprintf("%06d", sequence)
+	return imad, nil
+}
+
+// imadSequence is the last sequence number allocated to an input source
+type imadSequence struct {
+	CycleDate string `json:"cycleDate"`
+	Sequence  int    `json:"sequence"`
+}
+
+// nextIMADSequence allocates the next sequence number of inputSource within sequences
+func nextIMADSequence(sequences map[string]imadSequence, inputSource, cycleDate string) (int, error) {
+	last := sequences[inputSource]
+	switch {
+	case cycleDate < last.CycleDate:
+		return 0, fieldError("InputCycleDate", ErrIMADCycleDate, cycleDate)
+	case cycleDate > last.CycleDate:
+		last = imadSequence{CycleDate: cycleDate}
+	}
+	if last.Sequence >= maxIMADSequence {
+		return 0, fieldError("InputSequenceNumber", ErrIMADSequenceExhausted, cycleDate)
+	}
+	last.Sequence++
+	sequences[inputSource] = last
+	return last.Sequence, nil
+}
+
+// MemoryIMADStore is an IMADStore which keeps sequence numbers in memory, so they are reused after a restart
+type MemoryIMADStore struct {
+	mu        sync.Mutex
+	sequences map[string]imadSequence
+}
+
+// NewMemoryIMADStore returns a new MemoryIMADStore
+func NewMemoryIMADStore() *MemoryIMADStore {
+	return &MemoryIMADStore{
+		sequences: make(map[string]imadSequence),
+	}
+}
+
+// NextSequence allocates and returns the next sequence number for the input source on cycleDate
+func (s *MemoryIMADStore) NextSequence(inputSource, cycleDate string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return nextIMADSequence(s.sequences, inputSource, cycleDate)
+}
+
+// FileIMADStore is an IMADStore which saves sequence numbers to a JSON file after each allocation. The file
+// is replaced atomically, so a crash leaves either the prior or the new sequence numbers. Only one process
+// may use the file at a time.
+type FileIMADStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileIMADStore returns a new FileIMADStore saving to path, which is created on first use
+func NewFileIMADStore(path string) *FileIMADStore {
+	return &FileIMADStore{
+		path: path,
+	}
+}
+
+// NextSequence allocates and returns the next sequence number for the input source on cycleDate
+func (s *FileIMADStore) NextSequence(inputSource, cycleDate string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sequences := make(map[string]imadSequence)
+	bs, err := os.ReadFile(s.path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return 0, fmt.Errorf("reading IMAD sequences: %w", err)
+	default:
+		if err := json.Unmarshal(bs, &sequences); err != nil {
+			return 0, fmt.Errorf("reading IMAD sequences from %s: %w", s.path, err)
+		}
+	}
+
+	sequence, err := nextIMADSequence(sequences, inputSource, cycleDate)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.save(sequences); err != nil {
+		return 0, fmt.Errorf("saving IMAD sequences: %w", err)
+	}
+	return sequence, nil
+}
+
+// save writes sequences to a temporary file which then replaces the file at s.path
+func (s *FileIMADStore) save(sequences map[string]imadSequence) error {
+	bs, err := json.Marshal(sequences)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(bs); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.path)
+}
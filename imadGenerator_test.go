@@ -0,0 +1,123 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIMADGenerator(t *testing.T) {
+	now := time.Date(2019, time.April, 10, 14, 0, 0, 0, FedwireLocation)
+	g := NewIMADGenerator("Source08", NewMemoryIMADStore())
+	g.now = func() time.Time { return now }
+
+	imad, err := g.Next()
+	require.NoError(t, err)
+	require.Equal(t, "{1520}20190410Source08000001", imad.String())
+	require.NoError(t, imad.Validate())
+
+	imad, err = g.Next()
+	require.NoError(t, err)
+	require.Equal(t, "000002", imad.InputSequenceNumber)
+
+	// the next cycle opens at 9pm and restarts the sequence
+	now = time.Date(2019, time.April, 10, 21, 0, 0, 0, FedwireLocation)
+	imad, err = g.Next()
+	require.NoError(t, err)
+	require.Equal(t, "{1520}20190411Source08000001", imad.String())
+
+	// messages released after the close on Friday are given the cycle date of Monday
+	now = time.Date(2019, time.April, 12, 21, 0, 0, 0, FedwireLocation)
+	imad, err = g.Next()
+	require.NoError(t, err)
+	require.Equal(t, "20190415", imad.InputCycleDate)
+
+	// earlier cycles can't be reused
+	_, err = g.NextForCycleDate(time.Date(2019, time.April, 10, 0, 0, 0, 0, FedwireLocation))
+	require.ErrorIs(t, err, ErrIMADCycleDate)
+	require.Equal(t, "WIRE-1520-001", ErrorCode(err))
+
+	_, err = NewIMADGenerator("Sourçe08", NewMemoryIMADStore()).Next()
+	require.ErrorIs(t, err, ErrNonAlphanumeric)
+}
+
+func TestIMADGenerator_exhausted(t *testing.T) {
+	store := NewMemoryIMADStore()
+	store.sequences["Source08"] = imadSequence{CycleDate: "20190410", Sequence: 999998}
+	g := NewIMADGenerator("Source08", store)
+	cycleDate := time.Date(2019, time.April, 10, 0, 0, 0, 0, FedwireLocation)
+
+	imad, err := g.NextForCycleDate(cycleDate)
+	require.NoError(t, err)
+	require.Equal(t, "999999", imad.InputSequenceNumber)
+
+	_, err = g.NextForCycleDate(cycleDate)
+	require.ErrorIs(t, err, ErrIMADSequenceExhausted)
+}
+
+func TestIMADGenerator_inputSources(t *testing.T) {
+	store := NewMemoryIMADStore()
+	cycleDate := time.Date(2019, time.April, 10, 0, 0, 0, 0, FedwireLocation)
+
+	var wg sync.WaitGroup
+	seen := make(chan string, 200)
+	for _, source := range []string{"Source08", "Source09"} {
+		g := NewIMADGenerator(source, store)
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				imad, err := g.NextForCycleDate(cycleDate)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				seen <- imad.String()
+			}()
+		}
+	}
+	wg.Wait()
+	close(seen)
+
+	unique := make(map[string]bool)
+	for imad := range seen {
+		unique[imad] = true
+	}
+	require.Len(t, unique, 200)
+}
+
+func TestFileIMADStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "imad.json")
+	cycleDate := time.Date(2019, time.April, 10, 0, 0, 0, 0, FedwireLocation)
+
+	imad, err := NewIMADGenerator("Source08", NewFileIMADStore(path)).NextForCycleDate(cycleDate)
+	require.NoError(t, err)
+	require.Equal(t, "000001", imad.InputSequenceNumber)
+
+	// a new store, as after a restart, continues the sequence
+	g := NewIMADGenerator("Source08", NewFileIMADStore(path))
+	imad, err = g.NextForCycleDate(cycleDate)
+	require.NoError(t, err)
+	require.Equal(t, "000002", imad.InputSequenceNumber)
+
+	bs, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"Source08":{"cycleDate":"20190410","sequence":2}}`, string(bs))
+
+	// no temporary files are left behind
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0600))
+	_, err = g.NextForCycleDate(cycleDate)
+	require.ErrorContains(t, err, "reading IMAD sequences")
+}
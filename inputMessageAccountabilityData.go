@@ -7,6 +7,7 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"time"
 	"unicode/utf8"
 )
 
@@ -78,6 +79,11 @@ func (imad *InputMessageAccountabilityData) String() string {
 // Validate performs WIRE format rule checks on InputMessageAccountabilityData and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (imad *InputMessageAccountabilityData) Validate() error {
+	return imad.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on InputMessageAccountabilityData following opts, which may be nil
+func (imad *InputMessageAccountabilityData) validate(opts *ValidateOpts) error {
 	if err := imad.fieldInclusion(); err != nil {
 		return err
 	}
@@ -87,7 +93,7 @@ func (imad *InputMessageAccountabilityData) Validate() error {
 	if err := imad.validateDate(imad.InputCycleDate); err != nil {
 		return fieldError("InputCycleDate", err, imad.InputCycleDate)
 	}
-	if err := imad.isAlphanumeric(imad.InputSource); err != nil {
+	if err := imad.isAlphanumeric(opts, imad.InputSource); err != nil {
 		return fieldError("InputSource", err, imad.InputSource)
 	}
 	if err := imad.isNumeric(imad.InputSequenceNumber); err != nil {
@@ -125,3 +131,17 @@ func (imad *InputMessageAccountabilityData) InputSourceField() string {
 func (imad *InputMessageAccountabilityData) InputSequenceNumberField() string {
 	return imad.alphaField(imad.InputSequenceNumber, 6)
 }
+
+// CycleDate returns InputCycleDate as the start of the day in FedwireLocation
+func (imad *InputMessageAccountabilityData) CycleDate() (time.Time, error) {
+	t, err := parseCCYYMMDD(imad.InputCycleDate)
+	if err != nil {
+		return time.Time{}, fieldError("InputCycleDate", err, imad.InputCycleDate)
+	}
+	return t, nil
+}
+
+// SetCycleDate sets InputCycleDate to the date of t in FedwireLocation
+func (imad *InputMessageAccountabilityData) SetCycleDate(t time.Time) {
+	imad.InputCycleDate = formatCCYYMMDD(t)
+}
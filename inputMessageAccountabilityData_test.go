@@ -108,3 +108,20 @@ func TestInputMessageAccountabilityDataInputCycleDateError(t *testing.T) {
 
 	require.EqualError(t, imad.Validate(), fieldError("InputCycleDate", ErrValidDate, imad.InputCycleDate).Error())
 }
+
+// TestIMADCycleDate validates reading and setting InputCycleDate as a time.Time
+func TestIMADCycleDate(t *testing.T) {
+	imad := mockInputMessageAccountabilityData()
+	imad.InputCycleDate = "20190410"
+	cycleDate, err := imad.CycleDate()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.April, 10, 0, 0, 0, 0, FedwireLocation), cycleDate)
+
+	// 9pm in New York on April 10th is the next day in UTC
+	imad.SetCycleDate(time.Date(2019, time.April, 11, 1, 0, 0, 0, time.UTC))
+	require.Equal(t, "20190410", imad.InputCycleDate)
+
+	imad.InputCycleDate = "20191310"
+	_, err = imad.CycleDate()
+	require.ErrorIs(t, err, ErrValidDate)
+}
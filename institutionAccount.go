@@ -136,28 +136,36 @@ func (iAccount *InstitutionAccount) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on InstitutionAccount and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (iAccount *InstitutionAccount) Validate() error {
+	return iAccount.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on InstitutionAccount following opts, which may be nil
+func (iAccount *InstitutionAccount) validate(opts *ValidateOpts) error {
 	if err := iAccount.fieldInclusion(); err != nil {
 		return err
 	}
 	if iAccount.tag != TagInstitutionAccount {
 		return fieldError("tag", ErrValidTagForType, iAccount.tag)
 	}
-	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftFieldTag); err != nil {
+	if err := iAccount.isAlphanumeric(opts, iAccount.CoverPayment.SwiftFieldTag); err != nil {
 		return fieldError("SwiftFieldTag", err, iAccount.CoverPayment.SwiftFieldTag)
 	}
-	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineOne); err != nil {
+	if err := iAccount.isAlphanumeric(opts, iAccount.CoverPayment.SwiftLineOne); err != nil {
+		return fieldError("SwiftLineOne", err, iAccount.CoverPayment.SwiftLineOne)
+	}
+	if err := iAccount.validateSwiftAccountLine(opts, iAccount.CoverPayment.SwiftLineOne); err != nil {
 		return fieldError("SwiftLineOne", err, iAccount.CoverPayment.SwiftLineOne)
 	}
-	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineTwo); err != nil {
+	if err := iAccount.isAlphanumeric(opts, iAccount.CoverPayment.SwiftLineTwo); err != nil {
 		return fieldError("SwiftLineTwo", err, iAccount.CoverPayment.SwiftLineTwo)
 	}
-	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineThree); err != nil {
+	if err := iAccount.isAlphanumeric(opts, iAccount.CoverPayment.SwiftLineThree); err != nil {
 		return fieldError("SwiftLineThree", err, iAccount.CoverPayment.SwiftLineThree)
 	}
-	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineFour); err != nil {
+	if err := iAccount.isAlphanumeric(opts, iAccount.CoverPayment.SwiftLineFour); err != nil {
 		return fieldError("SwiftLineFour", err, iAccount.CoverPayment.SwiftLineFour)
 	}
-	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineFive); err != nil {
+	if err := iAccount.isAlphanumeric(opts, iAccount.CoverPayment.SwiftLineFive); err != nil {
 		return fieldError("SwiftLineFive", err, iAccount.CoverPayment.SwiftLineFive)
 	}
 	return nil
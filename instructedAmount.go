@@ -103,13 +103,18 @@ func (ia *InstructedAmount) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on InstructedAmount and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (ia *InstructedAmount) Validate() error {
+	return ia.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on InstructedAmount following opts, which may be nil
+func (ia *InstructedAmount) validate(opts *ValidateOpts) error {
 	if err := ia.fieldInclusion(); err != nil {
 		return err
 	}
 	if ia.tag != TagInstructedAmount {
 		return fieldError("tag", ErrValidTagForType, ia.tag)
 	}
-	if err := ia.isCurrencyCode(ia.CurrencyCode); err != nil {
+	if err := ia.isCurrencyCode(opts, ia.CurrencyCode); err != nil {
 		return fieldError("CurrencyCode", err, ia.CurrencyCode)
 	}
 	if err := ia.isAmount(ia.Amount); err != nil {
@@ -150,3 +155,35 @@ func (ia *InstructedAmount) FormatCurrencyCode(options FormatOptions) string {
 func (ia *InstructedAmount) FormatAmount(options FormatOptions) string {
 	return ia.formatAlphaField(ia.Amount, 15, options)
 }
+
+// MinorUnits returns Amount in the minor unit of CurrencyCode, such as 123456 for USD 1234,56
+func (ia *InstructedAmount) MinorUnits() (int64, error) {
+	exponent, err := currencyExponent(ia.CurrencyCode)
+	if err != nil {
+		return 0, fieldError("CurrencyCode", err, ia.CurrencyCode)
+	}
+	minor, err := parseMinorUnits(strings.TrimSpace(ia.Amount), ',', exponent)
+	if err != nil {
+		return 0, fieldError("Amount", err, ia.Amount)
+	}
+	return minor, nil
+}
+
+// SetMinorUnits sets CurrencyCode and sets Amount from a value in the minor unit of the currency,
+// such as USD and 123456 for USD 1234,56
+func (ia *InstructedAmount) SetMinorUnits(currencyCode string, minor int64) error {
+	exponent, err := currencyExponent(currencyCode)
+	if err != nil {
+		return fieldError("CurrencyCode", err, currencyCode)
+	}
+	amount, err := formatMinorUnits(minor, ',', exponent)
+	if err != nil {
+		return fieldError("Amount", err, minor)
+	}
+	if len(amount) > 15 {
+		return fieldError("Amount", ErrValidLength, amount)
+	}
+	ia.CurrencyCode = currencyCode
+	ia.Amount = amount
+	return nil
+}
@@ -142,3 +142,29 @@ func TestStringInstructedAmountOptions(t *testing.T) {
 	require.Equal(t, "{3710}USD4567,89*", record.Format(FormatOptions{VariableLengthFields: true}))
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
+
+// TestInstructedAmountMinorUnits validates reading and setting InstructedAmount in minor units
+func TestInstructedAmountMinorUnits(t *testing.T) {
+	ia := mockInstructedAmount()
+	require.NoError(t, ia.Parse("{3710}USD4567,89        *"))
+	minor, err := ia.MinorUnits()
+	require.NoError(t, err)
+	require.Equal(t, int64(456789), minor)
+
+	require.NoError(t, ia.SetMinorUnits("JPY", 1500))
+	require.Equal(t, "JPY", ia.CurrencyCode)
+	require.Equal(t, "1500", ia.Amount)
+	require.NoError(t, ia.Validate())
+
+	require.NoError(t, ia.SetMinorUnits("KWD", 1500))
+	require.Equal(t, "1,500", ia.Amount)
+
+	require.ErrorIs(t, ia.SetMinorUnits("ZZZ", 1500), ErrNonCurrencyCode)
+	require.ErrorIs(t, ia.SetMinorUnits("USD", -1), ErrNonAmount)
+	require.ErrorIs(t, ia.SetMinorUnits("USD", 1234567890123456), ErrValidLength)
+	require.Equal(t, "1,500", ia.Amount)
+
+	ia.CurrencyCode, ia.Amount = "USD", "12,345"
+	_, err = ia.MinorUnits()
+	require.ErrorIs(t, err, ErrNonAmount)
+}
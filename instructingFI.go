@@ -130,11 +130,16 @@ func (ifi *InstructingFI) Format(options FormatOptions) string {
 // The first error encountered is returned and stops that parsing.
 // If ID Code is present, Identifier is mandatory and vice versa.
 func (ifi *InstructingFI) Validate() error {
+	return ifi.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on InstructingFI following opts, which may be nil
+func (ifi *InstructingFI) validate(opts *ValidateOpts) error {
 	if ifi.tag != TagInstructingFI {
 		return fieldError("tag", ErrValidTagForType, ifi.tag)
 	}
 
-	if err := ifi.FinancialInstitution.Validate(); err != nil {
+	if err := ifi.FinancialInstitution.validate(opts); err != nil {
 		return err
 	}
 
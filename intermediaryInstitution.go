@@ -136,28 +136,36 @@ func (ii *IntermediaryInstitution) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on IntermediaryInstitution and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (ii *IntermediaryInstitution) Validate() error {
+	return ii.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on IntermediaryInstitution following opts, which may be nil
+func (ii *IntermediaryInstitution) validate(opts *ValidateOpts) error {
 	if err := ii.fieldInclusion(); err != nil {
 		return err
 	}
 	if ii.tag != TagIntermediaryInstitution {
 		return fieldError("tag", ErrValidTagForType, ii.tag)
 	}
-	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftFieldTag); err != nil {
+	if err := ii.isAlphanumeric(opts, ii.CoverPayment.SwiftFieldTag); err != nil {
 		return fieldError("SwiftFieldTag", err, ii.CoverPayment.SwiftFieldTag)
 	}
-	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineOne); err != nil {
+	if err := ii.isAlphanumeric(opts, ii.CoverPayment.SwiftLineOne); err != nil {
+		return fieldError("SwiftLineOne", err, ii.CoverPayment.SwiftLineOne)
+	}
+	if err := ii.validateSwiftAccountLine(opts, ii.CoverPayment.SwiftLineOne); err != nil {
 		return fieldError("SwiftLineOne", err, ii.CoverPayment.SwiftLineOne)
 	}
-	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineTwo); err != nil {
+	if err := ii.isAlphanumeric(opts, ii.CoverPayment.SwiftLineTwo); err != nil {
 		return fieldError("SwiftLineTwo", err, ii.CoverPayment.SwiftLineTwo)
 	}
-	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineThree); err != nil {
+	if err := ii.isAlphanumeric(opts, ii.CoverPayment.SwiftLineThree); err != nil {
 		return fieldError("SwiftLineThree", err, ii.CoverPayment.SwiftLineThree)
 	}
-	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineFour); err != nil {
+	if err := ii.isAlphanumeric(opts, ii.CoverPayment.SwiftLineFour); err != nil {
 		return fieldError("SwiftLineFour", err, ii.CoverPayment.SwiftLineFour)
 	}
-	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineFive); err != nil {
+	if err := ii.isAlphanumeric(opts, ii.CoverPayment.SwiftLineFive); err != nil {
 		return fieldError("SwiftLineFive", err, ii.CoverPayment.SwiftLineFive)
 	}
 	return nil
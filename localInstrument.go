@@ -106,6 +106,11 @@ func (li *LocalInstrument) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on LocalInstrument and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (li *LocalInstrument) Validate() error {
+	return li.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on LocalInstrument following opts, which may be nil
+func (li *LocalInstrument) validate(opts *ValidateOpts) error {
 	if err := li.fieldInclusion(); err != nil {
 		return err
 	}
@@ -115,7 +120,7 @@ func (li *LocalInstrument) Validate() error {
 	if err := li.isLocalInstrumentCode(li.LocalInstrumentCode); err != nil {
 		return fieldError("LocalInstrumentCode", err, li.LocalInstrumentCode)
 	}
-	if err := li.isAlphanumeric(li.ProprietaryCode); err != nil {
+	if err := li.isAlphanumeric(opts, li.ProprietaryCode); err != nil {
 		return fieldError("ProprietaryCode", err, li.ProprietaryCode)
 	}
 	return nil
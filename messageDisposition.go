@@ -129,6 +129,11 @@ func (md *MessageDisposition) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on MessageDisposition and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (md *MessageDisposition) Validate() error {
+	return md.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on MessageDisposition following opts, which may be nil
+func (md *MessageDisposition) validate(opts *ValidateOpts) error {
 	// Currently no validation as the FED is responsible for the values
 	if md.tag != TagMessageDisposition {
 		return fieldError("tag", ErrValidTagForType, md.tag)
@@ -0,0 +1,110 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/currency"
+)
+
+// ParseCurrencyAmount parses a currency code followed by an amount with a decimal comma marker, as used
+// by {3700} Charges, such as USD1234,56. The amount is returned in the minor unit of the currency, so
+// USD1234,56 is 123456 and JPY1500 is 1500. An error is returned when the amount has more decimals than
+// the currency's minor unit.
+func ParseCurrencyAmount(s string) (currencyCode string, minor int64, err error) {
+	if len(s) < 3 {
+		return "", 0, ErrNonCurrencyCode
+	}
+	currencyCode = s[:3]
+	exponent, err := currencyExponent(currencyCode)
+	if err != nil {
+		return "", 0, err
+	}
+	minor, err = parseMinorUnits(s[3:], ',', exponent)
+	if err != nil {
+		return "", 0, err
+	}
+	return currencyCode, minor, nil
+}
+
+// FormatCurrencyAmount returns the currency code followed by the amount in minor units with a decimal
+// comma marker, such as USD1234,56 for 123456. It is the reverse of ParseCurrencyAmount.
+func FormatCurrencyAmount(currencyCode string, minor int64) (string, error) {
+	exponent, err := currencyExponent(currencyCode)
+	if err != nil {
+		return "", err
+	}
+	amount, err := formatMinorUnits(minor, ',', exponent)
+	if err != nil {
+		return "", err
+	}
+	return currencyCode + amount, nil
+}
+
+// currencyExponent returns the number of decimals in the minor unit of an ISO 4217 currency, such as 2 for USD
+func currencyExponent(currencyCode string) (int, error) {
+	unit, err := currency.ParseISO(currencyCode)
+	if err != nil {
+		return 0, ErrNonCurrencyCode
+	}
+	scale, _ := currency.Standard.Rounding(unit)
+	return scale, nil
+}
+
+// parseMinorUnits parses an amount with an optional decimal marker, such as 1234,56, into an integer with
+// exponent implied decimals. Decimals past the exponent may only be zeros.
+func parseMinorUnits(s string, marker byte, exponent int) (int64, error) {
+	whole, fraction, _ := strings.Cut(s, string(marker))
+	if whole == "" || !isDigits(whole) || !isDigits(fraction) {
+		return 0, ErrNonAmount
+	}
+	if len(fraction) > exponent {
+		if strings.Trim(fraction[exponent:], "0") != "" {
+			return 0, ErrNonAmount
+		}
+		fraction = fraction[:exponent]
+	}
+	fraction += strings.Repeat("0", exponent-len(fraction))
+
+	var minor int64
+	for _, c := range whole + fraction {
+		digit := int64(c - '0')
+		if minor > (math.MaxInt64-digit)/10 {
+			return 0, ErrNonAmount
+		}
+		minor = minor*10 + digit
+	}
+	return minor, nil
+}
+
+// formatMinorUnits formats an integer with exponent implied decimals as an amount with a decimal marker,
+// such as 1234,56 for 123456. Zero decimals are kept so the amount shows the precision of the currency.
+func formatMinorUnits(minor int64, marker byte, exponent int) (string, error) {
+	if minor < 0 {
+		return "", ErrNonAmount
+	}
+	digits := strconv.FormatInt(minor, 10)
+	if exponent == 0 {
+		return digits, nil
+	}
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+	split := len(digits) - exponent
+	return digits[:split] + string(marker) + digits[split:], nil
+}
+
+// isDigits returns true when s only contains ASCII digits. An empty string is all digits.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
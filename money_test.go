@@ -0,0 +1,136 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCurrencyAmount(t *testing.T) {
+	cases := []struct {
+		input    string
+		currency string
+		minor    int64
+	}{
+		{"USD1234,56", "USD", 123456},
+		{"USD0,99", "USD", 99},
+		{"USD1234", "USD", 123400},
+		{"USD1234,5", "USD", 123450},
+		{"USD1234,560", "USD", 123456},
+		{"USD1,", "USD", 100},
+		{"JPY1500", "JPY", 1500},
+		{"KWD1,234", "KWD", 1234},
+		{"USD92233720368547758,07", "USD", math.MaxInt64},
+	}
+	for _, tc := range cases {
+		currency, minor, err := ParseCurrencyAmount(tc.input)
+		require.NoError(t, err, tc.input)
+		require.Equal(t, tc.currency, currency, tc.input)
+		require.Equal(t, tc.minor, minor, tc.input)
+	}
+
+	for _, input := range []string{"USD", "USD,99", "USD1.234,56", "USD1234,567", "USD-5", "USD 12", "JPY1,5", "USD92233720368547758,08"} {
+		_, _, err := ParseCurrencyAmount(input)
+		require.ErrorIs(t, err, ErrNonAmount, input)
+	}
+	for _, input := range []string{"", "US", "ZZZ1,00"} {
+		_, _, err := ParseCurrencyAmount(input)
+		require.ErrorIs(t, err, ErrNonCurrencyCode, input)
+	}
+}
+
+func TestFormatCurrencyAmount(t *testing.T) {
+	cases := []struct {
+		currency string
+		minor    int64
+		expected string
+	}{
+		{"USD", 123456, "USD1234,56"},
+		{"USD", 99, "USD0,99"},
+		{"USD", 5, "USD0,05"},
+		{"USD", 0, "USD0,00"},
+		{"JPY", 1500, "JPY1500"},
+		{"KWD", 1234, "KWD1,234"},
+	}
+	for _, tc := range cases {
+		s, err := FormatCurrencyAmount(tc.currency, tc.minor)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, s)
+
+		currency, minor, err := ParseCurrencyAmount(s)
+		require.NoError(t, err)
+		require.Equal(t, tc.currency, currency)
+		require.Equal(t, tc.minor, minor)
+	}
+
+	_, err := FormatCurrencyAmount("USD", -1)
+	require.ErrorIs(t, err, ErrNonAmount)
+	_, err = FormatCurrencyAmount("ZZZ", 1)
+	require.ErrorIs(t, err, ErrNonCurrencyCode)
+
+	// Charges use the same format
+	c := mockCharges()
+	c.SendersChargesOne, err = FormatCurrencyAmount("EUR", 1999)
+	require.NoError(t, err)
+	require.Equal(t, "EUR19,99", c.SendersChargesOne)
+	require.NoError(t, c.Validate())
+}
+
+func TestChargesMinorUnits(t *testing.T) {
+	c := mockCharges()
+	c.SendersChargesOne = "USD1234,56"
+	currency, minor, err := c.SendersChargesOneMinorUnits()
+	require.NoError(t, err)
+	require.Equal(t, "USD", currency)
+	require.Equal(t, int64(123456), minor)
+
+	require.NoError(t, c.SetSendersChargesTwoMinorUnits("JPY", 1500))
+	require.Equal(t, "JPY1500", c.SendersChargesTwo)
+	require.NoError(t, c.SetSendersChargesThreeMinorUnits("EUR", 99))
+	require.Equal(t, "EUR0,99", c.SendersChargesThree)
+	require.NoError(t, c.SetSendersChargesFourMinorUnits("KWD", 1234))
+	currency, minor, err = c.SendersChargesFourMinorUnits()
+	require.NoError(t, err)
+	require.Equal(t, "KWD", currency)
+	require.Equal(t, int64(1234), minor)
+	require.NoError(t, c.Validate())
+
+	// invalid amounts leave the field unchanged
+	require.ErrorIs(t, c.SetSendersChargesTwoMinorUnits("USD", -1), ErrNonAmount)
+	require.ErrorIs(t, c.SetSendersChargesTwoMinorUnits("ZZZ", 1), ErrNonCurrencyCode)
+	require.ErrorIs(t, c.SetSendersChargesTwoMinorUnits("USD", 1234567890123), ErrValidLength)
+	require.Equal(t, "JPY1500", c.SendersChargesTwo)
+
+	c.SendersChargesThree = "USD1.234,56"
+	_, _, err = c.SendersChargesThreeMinorUnits()
+	require.ErrorIs(t, err, ErrNonAmount)
+	var fe *FieldError
+	require.ErrorAs(t, err, &fe)
+	require.Equal(t, "SendersChargesThree", fe.FieldName)
+}
+
+func TestRemittanceAmountMinorUnits(t *testing.T) {
+	ra := RemittanceAmount{CurrencyCode: "USD", Amount: "1234.56"}
+	minor, err := ra.MinorUnits()
+	require.NoError(t, err)
+	require.Equal(t, int64(123456), minor)
+
+	ra.Amount = "1234.56789"
+	_, err = ra.MinorUnits()
+	require.ErrorIs(t, err, ErrNonAmount)
+
+	require.NoError(t, ra.SetMinorUnits("EUR", 7))
+	require.Equal(t, RemittanceAmount{CurrencyCode: "EUR", Amount: "0.07"}, ra)
+
+	require.ErrorIs(t, ra.SetMinorUnits("USD", math.MaxInt64), ErrValidLength)
+	require.Equal(t, RemittanceAmount{CurrencyCode: "EUR", Amount: "0.07"}, ra)
+
+	ra.CurrencyCode = "ZZZ"
+	_, err = ra.MinorUnits()
+	require.ErrorIs(t, err, ErrNonCurrencyCode)
+}
@@ -0,0 +1,179 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizeOpts contains options for Normalize
+type NormalizeOpts struct {
+	// Uppercase converts text to upper case once it has been transliterated.
+	// {8200} UnstructuredAddenda is not converted, as it may hold case sensitive formats such as XML.
+	Uppercase bool
+
+	// Replacement is written in place of characters which can't be transliterated.
+	// Those characters are removed when Replacement is empty.
+	Replacement string
+}
+
+// NormalizeChange describes a field changed by Normalize
+type NormalizeChange struct {
+	Tag    string // tag number holding the field, such as {4200}
+	Path   string // path of the field within the FEDWireMessage, such as Beneficiary.Personal.Name
+	Before string // value of the field before it was normalized
+	After  string // value of the field after it was normalized
+}
+
+// freeTextFields are the names of the fields Normalize changes: names, address lines and the text of the
+// remittance and information tags. Codes, amounts, dates and identifiers such as account numbers are
+// left alone.
+var freeTextFields = map[string]bool{
+	// names
+	"Name": true, "ContactName": true, "SenderShortName": true, "ReceiverShortName": true,
+	// addresses
+	"AddressLineOne": true, "AddressLineTwo": true, "AddressLineThree": true, "AddressLineFour": true,
+	"AddressLineFive": true, "AddressLineSix": true, "AddressLineSeven": true,
+	"Department": true, "SubDepartment": true, "StreetName": true, "BuildingNumber": true, "TownName": true,
+	"CountrySubDivisionState": true,
+	// information and remittance text, and the name and address lines of {5010} OriginatorOptionF
+	"LineOne": true, "LineTwo": true, "LineThree": true, "LineFour": true, "LineFive": true, "LineSix": true,
+	"LineSeven": true, "LineEight": true, "LineNine": true, "LineTen": true, "LineEleven": true, "LineTwelve": true,
+	"SwiftLineOne": true, "SwiftLineTwo": true, "SwiftLineThree": true, "SwiftLineFour": true,
+	"SwiftLineFive": true, "SwiftLineSix": true,
+	"AdditionalInformation": true, "AdditionalInfo": true, "ContactOther": true,
+	"Addenda": true,
+}
+
+// transliterations holds replacements for characters which are not removed by stripping accents
+var transliterations = map[rune]string{
+	'\t': " ", '\n': " ", '\r': " ", ' ': " ",
+	'‘': "'", '’': "'", '‚': "'", '‛': "'", '′': "'", '´': "'",
+	'“': `"`, '”': `"`, '„': `"`, '‟': `"`, '″': `"`, '«': `"`, '»': `"`,
+	'‐': "-", '‑': "-", '‒': "-", '–': "-", '—': "-", '―': "-", '−': "-",
+	'…': "...", '•': "-", '·': ".", '×': "x", '÷': "/",
+	'[': "(", ']': ")", '{': "(", '}': ")", '|': "/", '^': "",
+	'ß': "ss", 'Æ': "AE", 'æ': "ae", 'Œ': "OE", 'œ': "oe", 'Ø': "O", 'ø': "o",
+	'Ð': "D", 'ð': "d", 'Đ': "D", 'đ': "d", 'Þ': "TH", 'þ': "th", 'Ł': "L", 'ł': "l", 'ı': "i",
+	'€': "EUR", '£': "GBP", '¥': "JPY", '©': "(C)", '®': "(R)", '°': "",
+}
+
+// faimCharacters are the ASCII characters permitted by alphanumericRegex
+var faimCharacters = func() (allowed [utf8.RuneSelf]bool) {
+	for c := range allowed {
+		allowed[c] = !alphanumericRegex.MatchString(string(rune(c)))
+	}
+	return allowed
+}()
+
+// isFAIMCharacter returns true for the characters FAIM 3.0.6 permits in text fields
+func isFAIMCharacter(c rune) bool {
+	return c < utf8.RuneSelf && faimCharacters[c]
+}
+
+// Normalize transliterates the free text fields of fwm, such as names, address lines and remittance
+// information, to the characters permitted by FAIM 3.0.6, such as é to e, “ to " and — to -. Characters which
+// can't be transliterated are replaced with opts.Replacement. The AddendaLength of {8200} UnstructuredAddenda is
+// updated when it matched the length of the Addenda.
+//
+// Codes, amounts and identifiers, such as the account line which may begin a cover payment tag, are never
+// changed. Each field which was changed is returned in the order it was found. Unknown tags are not changed.
+func Normalize(fwm *FEDWireMessage, opts NormalizeOpts) []NormalizeChange {
+	if fwm == nil {
+		return nil
+	}
+
+	var changes []NormalizeChange
+	for _, tr := range fwm.tagRecords() {
+		tag := fieldTags[tr.field]
+		uppercase := opts.Uppercase && tag != TagUnstructuredAddenda
+
+		normalizeFields(reflect.ValueOf(tr.record).Elem(), tr.field, func(path, value string) string {
+			if isSwiftAccountLine(path, value) {
+				return value
+			}
+			normalized := normalizeText(value, opts.Replacement)
+			if uppercase {
+				normalized = strings.ToUpper(normalized)
+			}
+			if normalized != value {
+				changes = append(changes, NormalizeChange{Tag: tag, Path: path, Before: value, After: normalized})
+			}
+			return normalized
+		})
+	}
+
+	if ua := fwm.UnstructuredAddenda; ua != nil {
+		for _, change := range changes {
+			if change.Tag == TagUnstructuredAddenda && ua.AddendaLength == fmt.Sprintf("%04d", len(change.Before)) {
+				length := fmt.Sprintf("%04d", len(change.After))
+				changes = append(changes, NormalizeChange{
+					Tag:    TagUnstructuredAddenda,
+					Path:   "UnstructuredAddenda.AddendaLength",
+					Before: ua.AddendaLength,
+					After:  length,
+				})
+				ua.AddendaLength = length
+			}
+		}
+	}
+	return changes
+}
+
+// isSwiftAccountLine returns true when value, found at path, is the account line of a cover payment tag
+func isSwiftAccountLine(path, value string) bool {
+	return strings.HasSuffix(path, ".SwiftLineOne") && strings.HasPrefix(value, "/")
+}
+
+// normalizeFields calls normalize for each exported free text field within v and sets the field to its result
+func normalizeFields(v reflect.Value, path string, normalize func(path, value string) string) {
+	switch v.Kind() {
+	case reflect.String:
+		if v.CanSet() && freeTextFields[path[strings.LastIndex(path, ".")+1:]] {
+			v.SetString(normalize(path, v.String()))
+		}
+	case reflect.Pointer:
+		if !v.IsNil() {
+			normalizeFields(v.Elem(), path, normalize)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			normalizeFields(v.Index(i), fmt.Sprintf("%s[%d]", path, i), normalize)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if field := v.Type().Field(i); field.IsExported() {
+				normalizeFields(v.Field(i), path+"."+field.Name, normalize)
+			}
+		}
+	}
+}
+
+// normalizeText transliterates s so it only holds characters permitted by FAIM 3.0.6
+func normalizeText(s string, replacement string) string {
+	var buf strings.Builder
+	for _, c := range s {
+		if isFAIMCharacter(c) {
+			buf.WriteRune(c)
+			continue
+		}
+		if t, ok := transliterations[c]; ok {
+			buf.WriteString(t)
+			continue
+		}
+		// strip accents by decomposing c and keeping its base character
+		if base, _ := utf8.DecodeRuneInString(norm.NFD.String(string(c))); base != c && isFAIMCharacter(base) {
+			buf.WriteRune(base)
+			continue
+		}
+		buf.WriteString(replacement)
+	}
+	return buf.String()
+}
@@ -0,0 +1,135 @@
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.Name = "José “Pepe” Müller — Straße"
+	fwm.Originator = mockOriginator()
+	fwm.Originator.Personal.Address.AddressLineOne = "Øster Allé [Bldg 2]"
+	require.Error(t, fwm.ValidateAll())
+
+	changes := Normalize(&fwm, NormalizeOpts{})
+
+	require.Equal(t, `Jose "Pepe" Muller - Strasse`, fwm.Beneficiary.Personal.Name)
+	require.Equal(t, "Oster Alle (Bldg 2)", fwm.Originator.Personal.Address.AddressLineOne)
+	require.Equal(t, []NormalizeChange{
+		{
+			Tag:    TagBeneficiary,
+			Path:   "Beneficiary.Personal.Name",
+			Before: "José “Pepe” Müller — Straße",
+			After:  `Jose "Pepe" Muller - Strasse`,
+		},
+		{
+			Tag:    TagOriginator,
+			Path:   "Originator.Personal.Address.AddressLineOne",
+			Before: "Øster Allé [Bldg 2]",
+			After:  "Oster Alle (Bldg 2)",
+		},
+	}, changes)
+	require.NoError(t, fwm.ValidateAll())
+
+	// normalizing again changes nothing
+	require.Empty(t, Normalize(&fwm, NormalizeOpts{}))
+	require.Empty(t, Normalize(nil, NormalizeOpts{}))
+}
+
+func TestNormalize_Uppercase(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.Name = "Zoë Ærø"
+	fwm.Originator = mockOriginator()
+
+	changes := Normalize(&fwm, NormalizeOpts{Uppercase: true})
+
+	require.Equal(t, "ZOE AERO", fwm.Beneficiary.Personal.Name)
+	require.Contains(t, changes, NormalizeChange{
+		Tag:    TagBeneficiary,
+		Path:   "Beneficiary.Personal.Name",
+		Before: "Zoë Ærø",
+		After:  "ZOE AERO",
+	})
+	require.NoError(t, fwm.ValidateAll())
+}
+
+func TestNormalize_Replacement(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.Name = "Li 李 Wei*"
+
+	Normalize(&fwm, NormalizeOpts{})
+	require.Equal(t, "Li  Wei", fwm.Beneficiary.Personal.Name)
+
+	fwm.Beneficiary.Personal.Name = "Li 李 Wei*"
+	Normalize(&fwm, NormalizeOpts{Replacement: "?"})
+	require.Equal(t, "Li ? Wei?", fwm.Beneficiary.Personal.Name)
+}
+
+func TestNormalize_UnstructuredAddenda(t *testing.T) {
+	fwm := FEDWireMessage{UnstructuredAddenda: mockUnstructuredAddenda()}
+	fwm.UnstructuredAddenda.Addenda = "{ref: “ß”}"
+	fwm.UnstructuredAddenda.AddendaLength = "0015"
+
+	changes := Normalize(&fwm, NormalizeOpts{Uppercase: true})
+
+	// braces are replaced as {8200} is validated like other tags, but the text is not uppercased
+	require.Equal(t, `(ref: "ss")`, fwm.UnstructuredAddenda.Addenda)
+	require.Equal(t, "0011", fwm.UnstructuredAddenda.AddendaLength)
+	require.Equal(t, []NormalizeChange{
+		{
+			Tag:    TagUnstructuredAddenda,
+			Path:   "UnstructuredAddenda.Addenda",
+			Before: "{ref: “ß”}",
+			After:  `(ref: "ss")`,
+		},
+		{
+			Tag:    TagUnstructuredAddenda,
+			Path:   "UnstructuredAddenda.AddendaLength",
+			Before: "0015",
+			After:  "0011",
+		},
+	}, changes)
+	require.NoError(t, fwm.UnstructuredAddenda.Validate())
+}
+
+func TestNormalize_codesAndIdentifiers(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.SenderReference = mockSenderReference()
+	fwm.SenderReference.SenderReference = "ref-Müller"
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.IdentificationCode = SWIFTBankIdentifierCode
+	fwm.Beneficiary.Personal.Identifier = "deutdeff"
+	fwm.Beneficiary.Personal.Name = "Zoë"
+	fwm.OrderingCustomer = mockOrderingCustomer()
+	fwm.OrderingCustomer.CoverPayment.SwiftLineOne = "/de89370400440532013000"
+	fwm.OrderingCustomer.CoverPayment.SwiftLineTwo = "Zoë"
+	fwm.InstructedAmount = mockInstructedAmount()
+	fwm.InstructedAmount.CurrencyCode = "usd"
+
+	changes := Normalize(&fwm, NormalizeOpts{Uppercase: true})
+
+	require.Equal(t, "ref-Müller", fwm.SenderReference.SenderReference)
+	require.Equal(t, SWIFTBankIdentifierCode, fwm.Beneficiary.Personal.IdentificationCode)
+	require.Equal(t, "deutdeff", fwm.Beneficiary.Personal.Identifier)
+	require.Equal(t, "/de89370400440532013000", fwm.OrderingCustomer.CoverPayment.SwiftLineOne)
+	require.Equal(t, "usd", fwm.InstructedAmount.CurrencyCode)
+
+	// free text is still normalized
+	require.Equal(t, "ZOE", fwm.Beneficiary.Personal.Name)
+	require.Equal(t, "ZOE", fwm.OrderingCustomer.CoverPayment.SwiftLineTwo)
+	for _, change := range changes {
+		require.NotContains(t, []string{
+			"SenderReference.SenderReference",
+			"Beneficiary.Personal.IdentificationCode",
+			"Beneficiary.Personal.Identifier",
+			"OrderingCustomer.CoverPayment.SwiftLineOne",
+			"InstructedAmount.CurrencyCode",
+			"InstructedAmount.Amount",
+		}, change.Path)
+	}
+}
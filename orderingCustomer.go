@@ -136,28 +136,36 @@ func (oc *OrderingCustomer) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on OrderingCustomer and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (oc *OrderingCustomer) Validate() error {
+	return oc.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on OrderingCustomer following opts, which may be nil
+func (oc *OrderingCustomer) validate(opts *ValidateOpts) error {
 	if err := oc.fieldInclusion(); err != nil {
 		return err
 	}
 	if oc.tag != TagOrderingCustomer {
 		return fieldError("tag", ErrValidTagForType, oc.tag)
 	}
-	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftFieldTag); err != nil {
+	if err := oc.isAlphanumeric(opts, oc.CoverPayment.SwiftFieldTag); err != nil {
 		return fieldError("SwiftFieldTag", err, oc.CoverPayment.SwiftFieldTag)
 	}
-	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineOne); err != nil {
+	if err := oc.isAlphanumeric(opts, oc.CoverPayment.SwiftLineOne); err != nil {
+		return fieldError("SwiftLineOne", err, oc.CoverPayment.SwiftLineOne)
+	}
+	if err := oc.validateSwiftAccountLine(opts, oc.CoverPayment.SwiftLineOne); err != nil {
 		return fieldError("SwiftLineOne", err, oc.CoverPayment.SwiftLineOne)
 	}
-	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineTwo); err != nil {
+	if err := oc.isAlphanumeric(opts, oc.CoverPayment.SwiftLineTwo); err != nil {
 		return fieldError("SwiftLineTwo", err, oc.CoverPayment.SwiftLineTwo)
 	}
-	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineThree); err != nil {
+	if err := oc.isAlphanumeric(opts, oc.CoverPayment.SwiftLineThree); err != nil {
 		return fieldError("SwiftLineThree", err, oc.CoverPayment.SwiftLineThree)
 	}
-	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineFour); err != nil {
+	if err := oc.isAlphanumeric(opts, oc.CoverPayment.SwiftLineFour); err != nil {
 		return fieldError("SwiftLineFour", err, oc.CoverPayment.SwiftLineFour)
 	}
-	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineFive); err != nil {
+	if err := oc.isAlphanumeric(opts, oc.CoverPayment.SwiftLineFive); err != nil {
 		return fieldError("SwiftLineFive", err, oc.CoverPayment.SwiftLineFive)
 	}
 	return nil
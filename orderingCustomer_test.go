@@ -47,6 +47,17 @@ func TestOrderingCustomerSwiftLineOneAlphaNumeric(t *testing.T) {
 	require.EqualError(t, err, fieldError("SwiftLineOne", ErrNonAlphanumeric, oc.CoverPayment.SwiftLineOne).Error())
 }
 
+// TestOrderingCustomerSwiftLineOneIBAN validates the IBAN of an OrderingCustomer account
+func TestOrderingCustomerSwiftLineOneIBAN(t *testing.T) {
+	oc := mockOrderingCustomer()
+	oc.CoverPayment.SwiftLineOne = "/GB82WEST12345698765432"
+	require.NoError(t, oc.Validate())
+
+	oc.CoverPayment.SwiftLineOne = "/GB82WEST12345698765433"
+	err := oc.Validate()
+	require.EqualError(t, err, fieldError("SwiftLineOne", ErrIBAN, oc.CoverPayment.SwiftLineOne).Error())
+}
+
 // TestOrderingCustomerSwiftLineTwoAlphaNumeric validates OrderingCustomer SwiftLineTwo is alphanumeric
 func TestOrderingCustomerSwiftLineTwoAlphaNumeric(t *testing.T) {
 	oc := mockOrderingCustomer()
@@ -136,28 +136,36 @@ func (oi *OrderingInstitution) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on OrderingInstitution and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (oi *OrderingInstitution) Validate() error {
+	return oi.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on OrderingInstitution following opts, which may be nil
+func (oi *OrderingInstitution) validate(opts *ValidateOpts) error {
 	if err := oi.fieldInclusion(); err != nil {
 		return err
 	}
 	if oi.tag != TagOrderingInstitution {
 		return fieldError("tag", ErrValidTagForType, oi.tag)
 	}
-	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftFieldTag); err != nil {
+	if err := oi.isAlphanumeric(opts, oi.CoverPayment.SwiftFieldTag); err != nil {
 		return fieldError("SwiftFieldTag", err, oi.CoverPayment.SwiftFieldTag)
 	}
-	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineOne); err != nil {
+	if err := oi.isAlphanumeric(opts, oi.CoverPayment.SwiftLineOne); err != nil {
+		return fieldError("SwiftLineOne", err, oi.CoverPayment.SwiftLineOne)
+	}
+	if err := oi.validateSwiftAccountLine(opts, oi.CoverPayment.SwiftLineOne); err != nil {
 		return fieldError("SwiftLineOne", err, oi.CoverPayment.SwiftLineOne)
 	}
-	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineTwo); err != nil {
+	if err := oi.isAlphanumeric(opts, oi.CoverPayment.SwiftLineTwo); err != nil {
 		return fieldError("SwiftLineTwo", err, oi.CoverPayment.SwiftLineTwo)
 	}
-	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineThree); err != nil {
+	if err := oi.isAlphanumeric(opts, oi.CoverPayment.SwiftLineThree); err != nil {
 		return fieldError("SwiftLineThree", err, oi.CoverPayment.SwiftLineThree)
 	}
-	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineFour); err != nil {
+	if err := oi.isAlphanumeric(opts, oi.CoverPayment.SwiftLineFour); err != nil {
 		return fieldError("SwiftLineFour", err, oi.CoverPayment.SwiftLineFour)
 	}
-	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineFive); err != nil {
+	if err := oi.isAlphanumeric(opts, oi.CoverPayment.SwiftLineFive); err != nil {
 		return fieldError("SwiftLineFive", err, oi.CoverPayment.SwiftLineFive)
 	}
 	return nil
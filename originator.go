@@ -130,6 +130,11 @@ func (o *Originator) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on Originator and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (o *Originator) Validate() error {
+	return o.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on Originator following opts, which may be nil
+func (o *Originator) validate(opts *ValidateOpts) error {
 	if o.tag != TagOriginator {
 		return fieldError("tag", ErrValidTagForType, o.tag)
 	}
@@ -147,21 +152,24 @@ func (o *Originator) Validate() error {
 			return fieldError("IdentificationCode", err, o.Personal.IdentificationCode)
 		}
 		// Identifier text must only contain allowed characters
-		if err := o.isAlphanumeric(o.Personal.Identifier); err != nil {
+		if err := o.isAlphanumeric(opts, o.Personal.Identifier); err != nil {
+			return fieldError("Identifier", err, o.Personal.Identifier)
+		}
+		if err := o.validateAccountIdentifier(opts, o.Personal.IdentificationCode, o.Personal.Identifier); err != nil {
 			return fieldError("Identifier", err, o.Personal.Identifier)
 		}
 	}
 
-	if err := o.isAlphanumeric(o.Personal.Name); err != nil {
+	if err := o.isAlphanumeric(opts, o.Personal.Name); err != nil {
 		return fieldError("Name", err, o.Personal.Name)
 	}
-	if err := o.isAlphanumeric(o.Personal.Address.AddressLineOne); err != nil {
+	if err := o.isAlphanumeric(opts, o.Personal.Address.AddressLineOne); err != nil {
 		return fieldError("AddressLineOne", err, o.Personal.Address.AddressLineOne)
 	}
-	if err := o.isAlphanumeric(o.Personal.Address.AddressLineTwo); err != nil {
+	if err := o.isAlphanumeric(opts, o.Personal.Address.AddressLineTwo); err != nil {
 		return fieldError("AddressLineTwo", err, o.Personal.Address.AddressLineTwo)
 	}
-	if err := o.isAlphanumeric(o.Personal.Address.AddressLineThree); err != nil {
+	if err := o.isAlphanumeric(opts, o.Personal.Address.AddressLineThree); err != nil {
 		return fieldError("AddressLineThree", err, o.Personal.Address.AddressLineThree)
 	}
 	return nil
@@ -135,11 +135,16 @@ func (ofi *OriginatorFI) Format(options FormatOptions) string {
 // The first error encountered is returned and stops that parsing.
 // If ID Code is present, Identifier is mandatory and vice versa.
 func (ofi *OriginatorFI) Validate() error {
+	return ofi.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on OriginatorFI following opts, which may be nil
+func (ofi *OriginatorFI) validate(opts *ValidateOpts) error {
 	if ofi.tag != TagOriginatorFI {
 		return fieldError("tag", ErrValidTagForType, ofi.tag)
 	}
 
-	if err := ofi.FinancialInstitution.Validate(); err != nil {
+	if err := ofi.FinancialInstitution.validate(opts); err != nil {
 		return err
 	}
 
@@ -162,28 +162,28 @@ func TestOriginatorFITagError(t *testing.T) {
 
 // TestStringOriginatorFIVariableLength parses using variable length
 func TestStringOriginatorFIVariableLength(t *testing.T) {
-	var line = "{5100}B1*"
+	var line = "{5100}D1*"
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 
 	err := r.parseOriginatorFI()
 	require.NoError(t, err)
 
-	line = "{5100}B1                                                                                                                                                                             NNN"
+	line = "{5100}D1                                                                                                                                                                             NNN"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
 	err = r.parseOriginatorFI()
 	require.ErrorContains(t, err, ErrRequireDelimiter.Error())
 
-	line = "{5100}B1*******"
+	line = "{5100}D1*******"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
 	err = r.parseOriginatorFI()
 	require.ErrorContains(t, err, r.parseError(NewTagMaxLengthErr(errors.New(""))).Error())
 
-	line = "{5100}B1*"
+	line = "{5100}D1*"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -193,7 +193,7 @@ func TestStringOriginatorFIVariableLength(t *testing.T) {
 
 // TestStringOriginatorFIOptions validates Format() formatted according to the FormatOptions
 func TestStringOriginatorFIOptions(t *testing.T) {
-	var line = "{5100}B1*"
+	var line = "{5100}D1*"
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -201,7 +201,7 @@ func TestStringOriginatorFIOptions(t *testing.T) {
 	require.NoError(t, err)
 
 	record := r.currentFEDWireMessage.OriginatorFI
-	require.Equal(t, "{5100}B1                                 *                                   *                                   *                                   *                                   *", record.String())
-	require.Equal(t, "{5100}B1*", record.Format(FormatOptions{VariableLengthFields: true}))
+	require.Equal(t, "{5100}D1                                 *                                   *                                   *                                   *                                   *", record.String())
+	require.Equal(t, "{5100}D1*", record.Format(FormatOptions{VariableLengthFields: true}))
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
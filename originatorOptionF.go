@@ -192,24 +192,42 @@ func (oof *OriginatorOptionF) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on OriginatorOptionF and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (oof *OriginatorOptionF) Validate() error {
+	return oof.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on OriginatorOptionF following opts, which may be nil
+func (oof *OriginatorOptionF) validate(opts *ValidateOpts) error {
 	if err := oof.fieldInclusion(); err != nil {
 		return err
 	}
-	if err := oof.validatePartyIdentifier(oof.PartyIdentifier); err != nil {
+	if err := oof.validatePartyIdentifier(opts, oof.PartyIdentifier); err != nil {
 		return fieldError("PartyIdentifier", err, oof.PartyIdentifier)
 	}
-	if err := oof.validateOptionFName(oof.Name); err != nil {
+	if err := oof.validateSwiftAccountLine(opts, oof.PartyIdentifier); err != nil {
+		return fieldError("PartyIdentifier", err, oof.PartyIdentifier)
+	}
+	if err := oof.validateOptionFName(opts, oof.Name); err != nil {
 		return fieldError("Name", err, oof.Name)
 	}
-	if err := oof.validateOptionFLine(oof.LineOne); err != nil {
+	if err := oof.validateOptionFLine(opts, oof.LineOne); err != nil {
 		return fieldError("LineOne", err, oof.LineOne)
 	}
-	if err := oof.validateOptionFLine(oof.LineTwo); err != nil {
+	if err := oof.validateOptionFLine(opts, oof.LineTwo); err != nil {
 		return fieldError("LineTwo", err, oof.LineTwo)
 	}
-	if err := oof.validateOptionFLine(oof.LineThree); err != nil {
+	if err := oof.validateOptionFLine(opts, oof.LineThree); err != nil {
 		return fieldError("LineThree", err, oof.LineThree)
 	}
+	// only the first OptionFCountryTown line holds the country code, later ones continue the town
+	lines := []struct{ field, value string }{{"LineOne", oof.LineOne}, {"LineTwo", oof.LineTwo}, {"LineThree", oof.LineThree}}
+	for _, line := range lines {
+		if strings.HasPrefix(line.value, OptionFCountryTown+"/") {
+			if err := oof.validateOptionFCountryTown(opts, line.value); err != nil {
+				return fieldError(line.field, err, line.value)
+			}
+			break
+		}
+	}
 	return nil
 }
 
@@ -119,6 +119,18 @@ func TestOriginatorOptionFLineSlash(t *testing.T) {
 	require.EqualError(t, err, fieldError("LineOne", ErrOptionFLine, oof.LineOne).Error())
 }
 
+// TestOriginatorOptionFLineCountryTown validates the country code of OriginatorOptionF Country and Town lines
+func TestOriginatorOptionFLineCountryTown(t *testing.T) {
+	oof := mockOriginatorOptionF()
+	oof.LineTwo = "3/US/NEW YORK"
+	oof.LineThree = "3/MANHATTAN"
+	require.NoError(t, oof.Validate())
+
+	// only the first Country and Town line holds a country code
+	oof.LineTwo = "3/XX/NEW YORK"
+	require.EqualError(t, oof.Validate(), fieldError("LineTwo", ErrNonCountryCode, oof.LineTwo).Error())
+}
+
 // TestOriginatorOptionFLineOne validates OriginatorOptionF LineOne is valid
 func TestOriginatorOptionFLineOne(t *testing.T) {
 	oof := mockOriginatorOptionF()
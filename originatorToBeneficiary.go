@@ -127,19 +127,24 @@ func (ob *OriginatorToBeneficiary) Format(options FormatOptions) string {
 // The first error encountered is returned and stops that parsing.
 // See latest version of the FAIM manual for Line Limits for Tags {6000} to {6500}.
 func (ob *OriginatorToBeneficiary) Validate() error {
+	return ob.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on OriginatorToBeneficiary following opts, which may be nil
+func (ob *OriginatorToBeneficiary) validate(opts *ValidateOpts) error {
 	if ob.tag != TagOriginatorToBeneficiary {
 		return fieldError("tag", ErrValidTagForType, ob.tag)
 	}
-	if err := ob.isAlphanumeric(ob.LineOne); err != nil {
+	if err := ob.isAlphanumeric(opts, ob.LineOne); err != nil {
 		return fieldError("LineOne", err, ob.LineOne)
 	}
-	if err := ob.isAlphanumeric(ob.LineTwo); err != nil {
+	if err := ob.isAlphanumeric(opts, ob.LineTwo); err != nil {
 		return fieldError("LineTwo", err, ob.LineTwo)
 	}
-	if err := ob.isAlphanumeric(ob.LineThree); err != nil {
+	if err := ob.isAlphanumeric(opts, ob.LineThree); err != nil {
 		return fieldError("LineThree", err, ob.LineThree)
 	}
-	if err := ob.isAlphanumeric(ob.LineFour); err != nil {
+	if err := ob.isAlphanumeric(opts, ob.LineFour); err != nil {
 		return fieldError("LineFour", err, ob.LineFour)
 	}
 	return nil
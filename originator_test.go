@@ -150,28 +150,28 @@ func TestOriginatorTagError(t *testing.T) {
 
 // TestStringOriginatorVariableLength parses using variable length
 func TestStringOriginatorVariableLength(t *testing.T) {
-	var line = "{5000}B1*"
+	var line = "{5000}D1*"
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 
 	err := r.parseOriginator()
 	require.NoError(t, err)
 
-	line = "{5000}B1                                                                                                                                                                             NNN"
+	line = "{5000}D1                                                                                                                                                                             NNN"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
 	err = r.parseOriginator()
 	require.ErrorContains(t, err, ErrRequireDelimiter.Error())
 
-	line = "{5000}B1*******"
+	line = "{5000}D1*******"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
 	err = r.parseOriginator()
 	require.ErrorContains(t, err, r.parseError(NewTagMaxLengthErr(errors.New(""))).Error())
 
-	line = "{5000}B1*"
+	line = "{5000}D1*"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -181,7 +181,7 @@ func TestStringOriginatorVariableLength(t *testing.T) {
 
 // TestStringOriginatorOptions validates Format() formatted according to the FormatOptions
 func TestStringOriginatorOptions(t *testing.T) {
-	var line = "{5000}B1*"
+	var line = "{5000}D1*"
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -189,7 +189,7 @@ func TestStringOriginatorOptions(t *testing.T) {
 	require.NoError(t, err)
 
 	record := r.currentFEDWireMessage.Originator
-	require.Equal(t, "{5000}B1                                 *                                   *                                   *                                   *                                   *", record.String())
-	require.Equal(t, "{5000}B1*", record.Format(FormatOptions{VariableLengthFields: true}))
+	require.Equal(t, "{5000}D1                                 *                                   *                                   *                                   *                                   *", record.String())
+	require.Equal(t, "{5000}D1*", record.Format(FormatOptions{VariableLengthFields: true}))
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
@@ -7,6 +7,7 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"time"
 	"unicode/utf8"
 )
 
@@ -149,6 +150,11 @@ func (omad *OutputMessageAccountabilityData) Format(options FormatOptions) strin
 // Validate performs WIRE format rule checks on OutputMessageAccountabilityData and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (omad *OutputMessageAccountabilityData) Validate() error {
+	return omad.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on OutputMessageAccountabilityData following opts, which may be nil
+func (omad *OutputMessageAccountabilityData) validate(opts *ValidateOpts) error {
 	// Currently no validation as the FED is responsible for the values
 	if omad.tag != TagOutputMessageAccountabilityData {
 		return fieldError("tag", ErrValidTagForType, omad.tag)
@@ -210,3 +216,35 @@ func (omad *OutputMessageAccountabilityData) OutputFRBApplicationIdentificationF
 func (omad *OutputMessageAccountabilityData) FormatOutputFRBApplicationIdentification(options FormatOptions) string {
 	return omad.formatAlphaField(omad.OutputFRBApplicationIdentification, 4, options)
 }
+
+// CycleDate returns OutputCycleDate as the start of the day in FedwireLocation
+func (omad *OutputMessageAccountabilityData) CycleDate() (time.Time, error) {
+	t, err := parseCCYYMMDD(omad.OutputCycleDate)
+	if err != nil {
+		return time.Time{}, fieldError("OutputCycleDate", err, omad.OutputCycleDate)
+	}
+	return t, nil
+}
+
+// SetCycleDate sets OutputCycleDate to the date of t in FedwireLocation
+func (omad *OutputMessageAccountabilityData) SetCycleDate(t time.Time) {
+	omad.OutputCycleDate = formatCCYYMMDD(t)
+}
+
+// OutputTimestamp returns OutputDate and OutputTime in FedwireLocation, taking the year from OutputCycleDate
+func (omad *OutputMessageAccountabilityData) OutputTimestamp() (time.Time, error) {
+	cycleDate, err := omad.CycleDate()
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := parseMMDDHHMM(omad.OutputDate, omad.OutputTime, cycleDate)
+	if err != nil {
+		return time.Time{}, fieldError("OutputDate", err, omad.OutputDate+omad.OutputTime)
+	}
+	return t, nil
+}
+
+// SetOutputTimestamp sets OutputDate and OutputTime to t in FedwireLocation
+func (omad *OutputMessageAccountabilityData) SetOutputTimestamp(t time.Time) {
+	omad.OutputDate, omad.OutputTime = formatMMDDHHMM(t)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -109,3 +110,32 @@ func TestStringOutputMessageAccountabilityDataOptions(t *testing.T) {
 	require.Equal(t, "{1120}                000001            ", record.Format(FormatOptions{VariableLengthFields: true}))
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
+
+// TestOMADTimestamp validates reading and setting the OMAD dates and time as a time.Time
+func TestOMADTimestamp(t *testing.T) {
+	omad := mockOutputMessageAccountabilityData()
+	cycleDate, err := omad.CycleDate()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.May, 2, 0, 0, 0, 0, FedwireLocation), cycleDate)
+
+	ts, err := omad.OutputTimestamp()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.May, 2, 12, 30, 0, 0, FedwireLocation), ts)
+
+	// sent on New Year's Eve for the first cycle of the year
+	omad.OutputCycleDate = "20200102"
+	omad.OutputDate, omad.OutputTime = "1231", "2105"
+	ts, err = omad.OutputTimestamp()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.December, 31, 21, 5, 0, 0, FedwireLocation), ts)
+
+	omad.SetCycleDate(time.Date(2021, time.March, 1, 0, 0, 0, 0, FedwireLocation))
+	omad.SetOutputTimestamp(time.Date(2021, time.March, 1, 14, 45, 0, 0, time.UTC))
+	require.Equal(t, "20210301", omad.OutputCycleDate)
+	require.Equal(t, "0301", omad.OutputDate)
+	require.Equal(t, "0945", omad.OutputTime)
+
+	omad.OutputTime = "2460"
+	_, err = omad.OutputTimestamp()
+	require.ErrorIs(t, err, ErrValidDate)
+}
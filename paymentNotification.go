@@ -158,28 +158,33 @@ func (pn *PaymentNotification) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on PaymentNotification and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (pn *PaymentNotification) Validate() error {
+	return pn.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on PaymentNotification following opts, which may be nil
+func (pn *PaymentNotification) validate(opts *ValidateOpts) error {
 	if pn.tag != TagPaymentNotification {
 		return fieldError("tag", ErrValidTagForType, pn.tag)
 	}
 	if err := pn.isNumeric(pn.PaymentNotificationIndicator); err != nil {
 		return fieldError("PaymentNotificationIndicator", err, pn.PaymentNotificationIndicator)
 	}
-	if err := pn.isAlphanumeric(pn.ContactNotificationElectronicAddress); err != nil {
+	if err := pn.isAlphanumeric(opts, pn.ContactNotificationElectronicAddress); err != nil {
 		return fieldError("ContactNotificationElectronicAddress", err, pn.ContactNotificationElectronicAddress)
 	}
-	if err := pn.isAlphanumeric(pn.ContactName); err != nil {
+	if err := pn.isAlphanumeric(opts, pn.ContactName); err != nil {
 		return fieldError("ContactName", err, pn.ContactName)
 	}
-	if err := pn.isAlphanumeric(pn.ContactPhoneNumber); err != nil {
+	if err := pn.isAlphanumeric(opts, pn.ContactPhoneNumber); err != nil {
 		return fieldError("ContactPhoneNumber", err, pn.ContactPhoneNumber)
 	}
-	if err := pn.isAlphanumeric(pn.ContactMobileNumber); err != nil {
+	if err := pn.isAlphanumeric(opts, pn.ContactMobileNumber); err != nil {
 		return fieldError("ContactMobileNumber", err, pn.ContactMobileNumber)
 	}
-	if err := pn.isAlphanumeric(pn.ContactFaxNumber); err != nil {
+	if err := pn.isAlphanumeric(opts, pn.ContactFaxNumber); err != nil {
 		return fieldError("FaxNumber", err, pn.ContactFaxNumber)
 	}
-	if err := pn.isAlphanumeric(pn.EndToEndIdentification); err != nil {
+	if err := pn.isAlphanumeric(opts, pn.EndToEndIdentification); err != nil {
 		return fieldError("EndToEndIdentification", err, pn.EndToEndIdentification)
 	}
 	return nil
@@ -92,10 +92,15 @@ func (pmi *PreviousMessageIdentifier) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on PreviousMessageIdentifier and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (pmi *PreviousMessageIdentifier) Validate() error {
+	return pmi.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on PreviousMessageIdentifier following opts, which may be nil
+func (pmi *PreviousMessageIdentifier) validate(opts *ValidateOpts) error {
 	if pmi.tag != TagPreviousMessageIdentifier {
 		return fieldError("tag", ErrValidTagForType, pmi.tag)
 	}
-	if err := pmi.isAlphanumeric(pmi.PreviousMessageIdentifier); err != nil {
+	if err := pmi.isAlphanumeric(opts, pmi.PreviousMessageIdentifier); err != nil {
 		return fieldError("PreviousMessageIdentifier", err, pmi.PreviousMessageIdentifier)
 	}
 	return nil
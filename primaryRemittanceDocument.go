@@ -122,6 +122,11 @@ func (prd *PrimaryRemittanceDocument) Format(options FormatOptions) string {
 // Document Type Code and Document Identification Number are mandatory for each set of remittance data.
 // Proprietary Document Type Code is mandatory for Document Type Code PROP; otherwise not permitted.
 func (prd *PrimaryRemittanceDocument) Validate() error {
+	return prd.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on PrimaryRemittanceDocument following opts, which may be nil
+func (prd *PrimaryRemittanceDocument) validate(opts *ValidateOpts) error {
 	if err := prd.fieldInclusion(); err != nil {
 		return err
 	}
@@ -131,13 +136,13 @@ func (prd *PrimaryRemittanceDocument) Validate() error {
 	if err := prd.isDocumentTypeCode(prd.DocumentTypeCode); err != nil {
 		return fieldError("DocumentTypeCode", err, prd.DocumentTypeCode)
 	}
-	if err := prd.isAlphanumeric(prd.ProprietaryDocumentTypeCode); err != nil {
+	if err := prd.isAlphanumeric(opts, prd.ProprietaryDocumentTypeCode); err != nil {
 		return fieldError("ProprietaryDocumentTypeCode", err, prd.ProprietaryDocumentTypeCode)
 	}
-	if err := prd.isAlphanumeric(prd.DocumentIdentificationNumber); err != nil {
+	if err := prd.isAlphanumeric(opts, prd.DocumentIdentificationNumber); err != nil {
 		return fieldError("DocumentIdentificationNumber", err, prd.DocumentIdentificationNumber)
 	}
-	if err := prd.isAlphanumeric(prd.Issuer); err != nil {
+	if err := prd.isAlphanumeric(opts, prd.Issuer); err != nil {
 		return fieldError("Issuer", err, prd.Issuer)
 	}
 	return nil
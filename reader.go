@@ -6,17 +6,24 @@ package wire
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"regexp"
+	"slices"
 	"strings"
 	"unicode/utf8"
 
 	"github.com/moov-io/base"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
 )
 
 // Reader reads records from a ACH-encoded file.
 type Reader struct {
+	// input is the io.Reader given to NewReader
+	input io.Reader
 	// r handles the IO.Reader sent to be parser.
 	scanner *bufio.Scanner
 	// file is ach.file model being built as r is parsed.
@@ -32,8 +39,43 @@ type Reader struct {
 	tagName string
 	// errors holds each error encountered when attempting to parse the file
 	errors base.ErrorList
+	// messageErrors holds the errors encountered in the FEDWireMessage being read
+	messageErrors base.ErrorList
+	// pending holds the tags split from the last scanned segment which have not been parsed yet
+	pending []tagSegment
+	// offset is the number of bytes of input scanned so far
+	offset int64
+	// segment is r.line as it was found in the input
+	segment tagSegment
+	// prefix holds input preceding the next tag which didn't belong to any tag
+	prefix string
+	// unread is set when r.line has been read but belongs to the next FEDWireMessage
+	unread bool
 	// headerData holds header static data for file
 	headerData string
+	// previousTag is the tag read before r.line within the current FEDWireMessage
+	previousTag string
+	// opts change how the input is parsed
+	opts ReaderOpts
+	// format holds the FormatOptions detected in the input so far
+	format FormatOptions
+	// newlineDetected is set once a newline has been found between tags
+	newlineDetected bool
+	// messages is the number of FEDWireMessages found in the input so far
+	messages int
+	// messageLine is the line the current FEDWireMessage begins on
+	messageLine int
+	// tagLines holds the line each tag of the current FEDWireMessage was read from
+	tagLines map[string]int
+	// order holds the last tag read from each group of the current FEDWireMessage
+	order tagOrder
+	// err is the error which stopped reading, such as a limit set in ReaderOpts being exceeded
+	err error
+	// validateOpts are the ValidateOpts given to ReadWithOpts
+	validateOpts *ValidateOpts
+	// bodyRead is set once a tag other than those appended by the Fedwire Funds Service has been read
+	// in the current FEDWireMessage
+	bodyRead bool
 }
 
 var (
@@ -58,6 +100,7 @@ func (r *Reader) parseError(err error) error {
 // NewReader returns a new ACH Reader that reads from r.
 func NewReader(r io.Reader, opts ...FilePropertyFunc) *Reader {
 	reader := &Reader{
+		input:   r,
 		scanner: bufio.NewScanner(r),
 		File:    *NewFile(opts...),
 	}
@@ -67,11 +110,66 @@ func NewReader(r io.Reader, opts ...FilePropertyFunc) *Reader {
 	return reader
 }
 
-// addCurrentFEDWireMessage creates the current FEDWireMessage for the file being read. A successful
-// current FEDWireMessage will be added to r.File once parsed.
-/*func (r *Reader) addCurrentFEDWireMessage(fwm FEDWireMessage) {
-	r.currentFEDWireMessage = FEDWireMessage{}
-}*/
+// SetOptions changes how r parses its input. It should be called before reading.
+func (r *Reader) SetOptions(opts *ReaderOpts) {
+	if r == nil || opts == nil {
+		return
+	}
+	r.opts = *opts
+
+	if opts.Encoding == nil && opts.MaxBytes <= 0 && opts.MaxTagLength <= 0 {
+		return
+	}
+	input := r.input
+	if opts.MaxBytes > 0 {
+		input = &limitedReader{r: input, max: opts.MaxBytes}
+	}
+	if opts.Encoding != nil {
+		nel := runes.Map(func(c rune) rune {
+			if c == '\u0085' {
+				return '\n'
+			}
+			return c
+		})
+		input = transform.NewReader(input, transform.Chain(opts.Encoding.NewDecoder(), nel))
+	}
+	r.scanner = bufio.NewScanner(input)
+	r.scanner.Split(scanLinesWithSegmentFormat)
+	if opts.MaxTagLength > 0 {
+		// leave room for newlines within the tag and the start of the following tag
+		r.scanner.Buffer(nil, scanBufferSize(opts.MaxTagLength))
+	}
+}
+
+// scanBufferSize returns the largest buffer needed to scan tags of up to maxTagLength bytes
+func scanBufferSize(maxTagLength int) int {
+	return 2*maxTagLength + 64
+}
+
+// limitedReader reads from r until more than max bytes have been read, then fails with a FileTooLongErr
+type limitedReader struct {
+	r     io.Reader
+	n     int64
+	max   int64
+	probe [1]byte
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.n >= l.max {
+		// the input may end exactly at the limit
+		n, err := l.r.Read(l.probe[:])
+		if n > 0 {
+			return 0, NewFileTooLongErr("MaxBytes", l.max)
+		}
+		return 0, err
+	}
+	if remaining := l.max - l.n; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+	n, err := l.r.Read(p)
+	l.n += int64(n)
+	return n, err
+}
 
 // Read reads each line of the FED Wire file and defines which parser to use based
 // on the first character of each line. It also enforces FED Wire formatting rules and returns
@@ -85,38 +183,34 @@ func (r *Reader) ReadWithOpts(opts *ValidateOpts) (File, error) {
 }
 
 func (r *Reader) read(opts *ValidateOpts) (File, error) {
-	spiltString := func(line string) []string {
-
-		// strip new lines
-		line = strings.ReplaceAll(strings.ReplaceAll(line, "\r\n", ""), "\n", "")
-
-		// split line by tag again
-		indexes := tagRegex.FindAllStringIndex(line, -1)
-		var result []string
-		last := len(line)
-		for i := range indexes {
-			index := indexes[len(indexes)-1-i][0]
-			result = append([]string{line[index:last]}, result...)
-			last = index
-		}
-		return result
-	}
+	var messageErrors []base.ErrorList
 
+	r.validateOpts = opts
 	r.lineNum = 0
 	// read through the entire file
-	for r.scanner.Scan() {
-		line := r.scanner.Text()
-		for _, subLine := range spiltString(line) {
-			r.lineNum++
-			r.line = subLine
-			if err := r.parseLine(); err != nil {
-				r.errors.Add(err)
+	for r.readFEDWireMessage() {
+		r.addCurrentFEDWireMessage(len(messageErrors))
+		messageErrors = append(messageErrors, r.messageErrors)
+	}
+	if len(messageErrors) == 0 {
+		r.addCurrentFEDWireMessage(0)
+	}
+
+	// report which message each error belongs to when the file holds several
+	for i := range messageErrors {
+		for _, err := range messageErrors[i] {
+			if len(messageErrors) > 1 {
+				err = &MessageError{Index: i, Err: err}
 			}
+			r.errors.Add(err)
 		}
 	}
+	if r.err != nil {
+		r.errors.Add(r.err)
+	}
 
-	r.File.AddFEDWireMessage(r.currentFEDWireMessage)
-	r.currentFEDWireMessage = FEDWireMessage{}
+	format := r.FormatOptions()
+	r.File.SourceFormatOptions = &format
 
 	if r.errors.Empty() {
 		if opts != nil {
@@ -126,11 +220,407 @@ func (r *Reader) read(opts *ValidateOpts) (File, error) {
 		if err == nil {
 			return r.File, nil
 		}
-		r.errors.Add(fmt.Errorf("file validation failed: %v", err))
+		// add each error on its own, as an ErrorList can't be unwrapped
+		if el, ok := err.(base.ErrorList); ok {
+			for _, err := range el {
+				r.errors.Add(fmt.Errorf("file validation failed: %w", err))
+			}
+		} else {
+			r.errors.Add(fmt.Errorf("file validation failed: %w", err))
+		}
 	}
 	return r.File, r.errors
 }
 
+// FormatOptions returns the format detected in the input read so far. VariableLengthFields is set once
+// a tag without fixed-width fields has been read and NewlineCharacter holds the newline found between
+// tags, which is "\r\n" when CRLF was used and empty when tags aren't separated by newlines.
+func (r *Reader) FormatOptions() FormatOptions {
+	return r.format
+}
+
+// Next reads the next FEDWireMessage from the input and returns it as soon as all of its tags
+// have been read. Messages returned by Next are not kept on r.File, so inputs of any size can be
+// processed without holding them in memory.
+//
+// Next returns the message along with a base.ErrorList when tags fail to parse, or a validation
+// error when the message is invalid. io.EOF is returned once the input holds no more messages.
+// Messages are validated with the ValidateOpts configured by the FilePropertyFuncs given to NewReader.
+func (r *Reader) Next() (*FEDWireMessage, error) {
+	if !r.readFEDWireMessage() {
+		if r.err != nil {
+			return nil, r.err
+		}
+		return nil, io.EOF
+	}
+	fwm := r.currentFEDWireMessage
+	r.currentFEDWireMessage = FEDWireMessage{}
+
+	if r.err != nil {
+		// the message was cut short
+		return &fwm, r.err
+	}
+	if !r.messageErrors.Empty() {
+		return &fwm, r.messageErrors
+	}
+	fwm.ValidateOptions = r.File.GetValidation()
+	if err := fwm.verify(); err != nil {
+		return &fwm, fmt.Errorf("message validation failed: %w", err)
+	}
+	return &fwm, nil
+}
+
+// All returns an iterator over the FEDWireMessages in the input. Each message and its error
+// are produced as described by Next and iteration stops at the end of the input.
+func (r *Reader) All() iter.Seq2[*FEDWireMessage, error] {
+	return func(yield func(*FEDWireMessage, error) bool) {
+		for {
+			fwm, err := r.Next()
+			if err == io.EOF {
+				return
+			}
+			if !yield(fwm, err) || r.err != nil {
+				return
+			}
+		}
+	}
+}
+
+// readFEDWireMessage parses tags into r.currentFEDWireMessage until the next message begins or the
+// input ends. Errors are collected in r.messageErrors. It returns false if there were no tags left to read.
+func (r *Reader) readFEDWireMessage() bool {
+	r.messageErrors = nil
+	r.previousTag = ""
+	r.tagLines = make(map[string]int)
+	r.order = make(tagOrder)
+	r.bodyRead = false
+
+	found := false
+	for r.nextLine() {
+		if r.startsNewFEDWireMessage() {
+			r.unread = true
+			break
+		}
+		if !found && !r.beginFEDWireMessage(r.lineNum) {
+			return false
+		}
+		found = true
+		if isBodyTag(r.line) {
+			r.bodyRead = true
+		}
+		if !r.checkDuplicateTag() {
+			continue
+		}
+		if r.opts.CheckTagOrder && len(r.line) >= 6 {
+			if err := r.order.check(r.line[:6], r.lineNum-r.messageLine+1); err != nil {
+				r.tagName = tagFields[r.line[:6]]
+				r.messageErrors.Add(r.parseError(err))
+			}
+		}
+		if err := r.parseLine(); err != nil {
+			r.messageErrors.Add(err)
+		} else if r.opts.RecordSpans && len(r.line) >= 6 && tagRegex.MatchString(r.line[:6]) {
+			if r.currentFEDWireMessage.Spans == nil {
+				r.currentFEDWireMessage.Spans = make(map[string]Span)
+			}
+			r.currentFEDWireMessage.Spans[r.line[:6]] = r.segment.span
+		}
+		if len(r.line) >= 6 {
+			if _, ok := tagFields[r.line[:6]]; ok {
+				r.currentFEDWireMessage.readTags = append(r.currentFEDWireMessage.readTags, r.line[:6])
+			}
+		}
+		if r.opts.PreserveFormat && len(r.line) >= 6 && tagRegex.MatchString(r.line[:6]) {
+			r.recordTagFormat()
+		}
+		r.detectFormat()
+		if len(r.line) >= 6 && tagRegex.MatchString(r.line[:6]) {
+			r.previousTag = r.line[:6]
+		}
+	}
+	return found
+}
+
+// beginFEDWireMessage counts a FEDWireMessage beginning on line. It returns false when ReaderOpts.MaxMessages
+// is exceeded.
+func (r *Reader) beginFEDWireMessage(line int) bool {
+	if r.opts.MaxMessages > 0 && r.messages >= r.opts.MaxMessages {
+		r.tagName = r.line[:6]
+		r.err = r.parseError(NewFileTooLongErr("MaxMessages", int64(r.opts.MaxMessages)))
+		return false
+	}
+	r.messages++
+	r.messageLine = line
+	return true
+}
+
+// validateTag validates tag as it is read, following the ValidateOpts given to ReadWithOpts or
+// configured by the FilePropertyFuncs given to NewReader
+func (r *Reader) validateTag(tag interface{ validate(*ValidateOpts) error }) error {
+	opts := r.validateOpts
+	if opts == nil {
+		opts = r.File.GetValidation()
+	}
+	if len(r.line) >= 6 && opts.skips(tagFields[r.line[:6]]) {
+		return nil
+	}
+	return tag.validate(opts)
+}
+
+// checkDuplicateTag records the line each tag was read from. It returns false when r.line repeats a tag
+// of the current FEDWireMessage and should not be parsed, according to ReaderOpts.DuplicateTags.
+func (r *Reader) checkDuplicateTag() bool {
+	if len(r.line) < 6 {
+		return true
+	}
+	tag := r.line[:6]
+	field, ok := tagFields[tag]
+	if !ok {
+		// unknown tags may be repeated
+		return true
+	}
+	firstLine, seen := r.tagLines[tag]
+	if !seen {
+		r.tagLines[tag] = r.lineNum
+		return true
+	}
+
+	switch r.opts.DuplicateTags {
+	case DuplicateTagsFail:
+		r.tagName = field
+		r.messageErrors.Add(r.parseError(NewErrDuplicateTag(tag, firstLine, r.lineNum)))
+		return false
+	case DuplicateTagsKeepFirst:
+		return false
+	}
+	r.tagLines[tag] = r.lineNum
+	r.currentFEDWireMessage.readTags = slices.DeleteFunc(r.currentFEDWireMessage.readTags, func(t string) bool {
+		return t == tag
+	})
+	if sf := r.currentFEDWireMessage.SourceFormat; sf != nil {
+		sf.Tags = slices.DeleteFunc(sf.Tags, func(tf TagFormat) bool {
+			return tf.Tag == tag
+		})
+	}
+	return true
+}
+
+// nextLine moves r.line onto the next tag of the input. It returns false at the end of the input.
+func (r *Reader) nextLine() bool {
+	if r.unread {
+		r.unread = false
+		return true
+	}
+	if r.err != nil {
+		return false
+	}
+	for len(r.pending) == 0 {
+		if !r.scanner.Scan() {
+			r.scanError(r.scanner.Err())
+			return false
+		}
+		token := r.scanner.Text()
+		r.pending = spiltString(token, r.offset)
+		r.offset += int64(len(token))
+		if len(r.pending) == 0 {
+			r.prefix += token
+		} else {
+			r.pending[0].prefix = r.prefix + r.pending[0].prefix
+			r.prefix = ""
+		}
+	}
+	r.segment, r.pending = r.pending[0], r.pending[1:]
+	r.line = r.segment.line
+	r.lineNum++
+
+	switch {
+	case r.opts.MaxTags > 0 && r.lineNum > r.opts.MaxTags:
+		r.err = NewFileTooLongErr("MaxTags", int64(r.opts.MaxTags))
+	case r.opts.MaxTagLength > 0 && len(r.line) > r.opts.MaxTagLength:
+		r.err = NewTagTooLongErr(r.opts.MaxTagLength, len(r.line))
+	default:
+		return true
+	}
+	r.tagName = r.line[:6]
+	r.err = r.parseError(r.err)
+	return false
+}
+
+// scanError sets r.err when the input could not be scanned
+func (r *Reader) scanError(err error) {
+	if err == nil {
+		return
+	}
+	if errors.Is(err, bufio.ErrTooLong) && r.opts.MaxTagLength > 0 {
+		// the tag didn't fit within the scan buffer
+		err = NewTagTooLongErr(r.opts.MaxTagLength, scanBufferSize(r.opts.MaxTagLength))
+	}
+	r.lineNum++
+	r.err = r.parseError(err)
+}
+
+// tagSegment is a tag split from a scanned segment of the input
+type tagSegment struct {
+	line   string // the tag with newlines removed
+	span   Span   // where the tag was found in the input
+	prefix string // input preceding the tag which isn't part of any tag
+	suffix string // input following the tag until the next one, such as newlines
+}
+
+// spiltString strips newlines from a scanned segment and splits it into one tagSegment per tag.
+// offset is the position of the segment within the input.
+func spiltString(token string, offset int64) []tagSegment {
+	// strip new lines, remembering where each remaining byte was in token
+	var line strings.Builder
+	positions := make([]int, 0, len(token))
+	for i := 0; i < len(token); i++ {
+		if token[i] == '\n' || (token[i] == '\r' && i+1 < len(token) && token[i+1] == '\n') {
+			continue
+		}
+		line.WriteByte(token[i])
+		positions = append(positions, i)
+	}
+	stripped := line.String()
+
+	// split line by tag again
+	indexes := tagRegex.FindAllStringIndex(stripped, -1)
+	result := make([]tagSegment, 0, len(indexes))
+	for i := range indexes {
+		start, end := indexes[i][0], len(stripped)
+		if i+1 < len(indexes) {
+			end = indexes[i+1][0]
+		}
+		rawStart, rawEnd := positions[start], positions[end-1]+1
+		result = append(result, tagSegment{
+			line: stripped[start:end],
+			span: Span{
+				Offset: offset + int64(rawStart),
+				Length: rawEnd - rawStart,
+				Raw:    token[rawStart:rawEnd],
+			},
+		})
+	}
+	// keep the input between tags
+	for i := range result {
+		rawStart := int(result[i].span.Offset - offset)
+		if i == 0 {
+			result[i].prefix = token[:rawStart]
+		} else {
+			previous := &result[i-1]
+			previous.suffix = token[int(previous.span.Offset-offset)+previous.span.Length : rawStart]
+		}
+	}
+	if n := len(result); n > 0 {
+		last := result[n-1]
+		result[n-1].suffix = token[int(last.span.Offset-offset)+last.span.Length:]
+	}
+	return result
+}
+
+// detectFormat updates r.format with how r.line was formatted in the input
+func (r *Reader) detectFormat() {
+	if !r.newlineDetected {
+		if i := strings.Index(r.segment.suffix, "\n"); i >= 0 {
+			r.format.NewlineCharacter = "\n"
+			if i > 0 && r.segment.suffix[i-1] == '\r' {
+				r.format.NewlineCharacter = "\r\n"
+			}
+			r.newlineDetected = true
+		}
+	}
+	if !r.format.VariableLengthFields && len(r.line) >= 6 {
+		if record := r.currentFEDWireMessage.tagRecord(r.line[:6]); record != nil {
+			r.format.VariableLengthFields = record.String() != r.line
+		}
+	}
+}
+
+// recordTagFormat keeps how r.line was formatted in the input on the current FEDWireMessage
+func (r *Reader) recordTagFormat() {
+	fwm := &r.currentFEDWireMessage
+	if fwm.SourceFormat == nil {
+		fwm.SourceFormat = &SourceFormat{}
+	}
+	tf := TagFormat{
+		Tag:               r.line[:6],
+		Raw:               r.segment.span.Raw,
+		Prefix:            r.segment.prefix,
+		Suffix:            r.segment.suffix,
+		TrailingDelimiter: strings.HasSuffix(r.line, Delimiter),
+		value:             r.line,
+	}
+	if record := fwm.tagRecord(tf.Tag); record != nil {
+		tf.value = record.String()
+		tf.VariableLength = r.line != tf.value
+	}
+	fwm.SourceFormat.Tags = append(fwm.SourceFormat.Tags, tf)
+}
+
+// addCurrentFEDWireMessage adds the current FEDWireMessage to r.File at index and starts a new one.
+func (r *Reader) addCurrentFEDWireMessage(index int) {
+	if index == 0 {
+		opts := r.File.FEDWireMessage.ValidateOptions
+		r.File.FEDWireMessage = r.currentFEDWireMessage
+		if r.File.FEDWireMessage.ValidateOptions == nil {
+			r.File.FEDWireMessage.ValidateOptions = opts
+		}
+	} else {
+		r.File.AdditionalFEDWireMessages = append(r.File.AdditionalFEDWireMessages, r.currentFEDWireMessage)
+	}
+	r.currentFEDWireMessage = FEDWireMessage{}
+}
+
+// startsNewFEDWireMessage returns true when r.line begins another FEDWireMessage.
+//
+// A {1500} SenderSupplied tag begins a message once the body of the current message has been read, as does
+// a {1100} MessageDisposition tag. A {1100} MessageDisposition tag also begins a message when the current
+// message already holds one. Fed appended tags ending the input aren't followed by another message, so
+// they belong to the last message.
+func (r *Reader) startsNewFEDWireMessage() bool {
+	if len(r.line) < 6 {
+		return false
+	}
+	switch r.line[:6] {
+	case TagSenderSupplied:
+		return r.bodyRead
+	case TagMessageDisposition:
+		return r.currentFEDWireMessage.MessageDisposition != nil || (r.bodyRead && r.bodyFollows())
+	}
+	return false
+}
+
+// fedAppendedTags are the tags added to a message by the Fedwire Funds Service
+var fedAppendedTags = map[string]bool{
+	TagMessageDisposition:              true,
+	TagReceiptTimeStamp:                true,
+	TagOutputMessageAccountabilityData: true,
+	TagErrorWire:                       true,
+}
+
+// isBodyTag returns true when line holds a known tag other than those appended by the Fedwire Funds Service
+func isBodyTag(line string) bool {
+	if len(line) < 6 {
+		return false
+	}
+	_, ok := tagFields[line[:6]]
+	return ok && !fedAppendedTags[line[:6]]
+}
+
+// bodyFollows returns true when a tag of a message body follows r.line in the input. The tags read to find
+// out are put back so they are parsed as usual.
+func (r *Reader) bodyFollows() bool {
+	line, segment, lineNum := r.line, r.segment, r.lineNum
+	var read []tagSegment
+	found := false
+	for !found && r.nextLine() {
+		read = append(read, r.segment)
+		found = isBodyTag(r.line)
+	}
+	r.pending = append(read, r.pending...)
+	r.line, r.segment, r.lineNum = line, segment, lineNum
+	return found
+}
+
 func (r *Reader) parseLine() error { //nolint:gocyclo
 	if n := utf8.RuneCountInString(r.line); n < 6 {
 		return fmt.Errorf("line %q is too short for tag", r.line)
@@ -381,6 +871,14 @@ func (r *Reader) parseLine() error { //nolint:gocyclo
 			r.headerData = r.line
 			return nil
 		}
+		if r.opts.KeepUnknownTags {
+			r.currentFEDWireMessage.UnknownTags = append(r.currentFEDWireMessage.UnknownTags, UnknownTag{
+				Tag:   r.line[:6],
+				Data:  r.line[6:],
+				After: r.previousTag,
+			})
+			return nil
+		}
 		return NewErrInvalidTag(r.line[:6])
 	}
 	return nil
@@ -392,7 +890,7 @@ func (r *Reader) parseSenderSupplied() error {
 	if err := ss.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ss.Validate(); err != nil {
+	if err := r.validateTag(ss); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.SenderSupplied = ss
@@ -405,7 +903,7 @@ func (r *Reader) parseTypeSubType() error {
 	if err := tst.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := tst.Validate(); err != nil {
+	if err := r.validateTag(tst); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.TypeSubType = tst
@@ -418,7 +916,7 @@ func (r *Reader) parseInputMessageAccountabilityData() error {
 	if err := imad.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := imad.Validate(); err != nil {
+	if err := r.validateTag(imad); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.InputMessageAccountabilityData = imad
@@ -431,7 +929,7 @@ func (r *Reader) parseAmount() error {
 	if err := amt.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := amt.Validate(); err != nil {
+	if err := r.validateTag(amt); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.Amount = amt
@@ -444,7 +942,7 @@ func (r *Reader) parseSenderDepositoryInstitution() error {
 	if err := sdi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := sdi.Validate(); err != nil {
+	if err := r.validateTag(sdi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.SenderDepositoryInstitution = sdi
@@ -457,7 +955,7 @@ func (r *Reader) parseReceiverDepositoryInstitution() error {
 	if err := rdi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := rdi.Validate(); err != nil {
+	if err := r.validateTag(rdi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.ReceiverDepositoryInstitution = rdi
@@ -470,7 +968,7 @@ func (r *Reader) parseBusinessFunctionCode() error {
 	if err := bfc.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := bfc.Validate(); err != nil {
+	if err := r.validateTag(bfc); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.BusinessFunctionCode = bfc
@@ -483,7 +981,7 @@ func (r *Reader) parseSenderReference() error {
 	if err := sr.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := sr.Validate(); err != nil {
+	if err := r.validateTag(sr); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.SenderReference = sr
@@ -496,7 +994,7 @@ func (r *Reader) parsePreviousMessageIdentifier() error {
 	if err := pmi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := pmi.Validate(); err != nil {
+	if err := r.validateTag(pmi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.PreviousMessageIdentifier = pmi
@@ -509,7 +1007,7 @@ func (r *Reader) parseLocalInstrument() error {
 	if err := li.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := li.Validate(); err != nil {
+	if err := r.validateTag(li); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.LocalInstrument = li
@@ -522,7 +1020,7 @@ func (r *Reader) parsePaymentNotification() error {
 	if err := pn.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := pn.Validate(); err != nil {
+	if err := r.validateTag(pn); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.PaymentNotification = pn
@@ -535,7 +1033,7 @@ func (r *Reader) parseCharges() error {
 	if err := c.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := c.Validate(); err != nil {
+	if err := r.validateTag(c); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.Charges = c
@@ -548,7 +1046,7 @@ func (r *Reader) parseInstructedAmount() error {
 	if err := ia.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ia.Validate(); err != nil {
+	if err := r.validateTag(ia); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.InstructedAmount = ia
@@ -561,7 +1059,7 @@ func (r *Reader) parseExchangeRate() error {
 	if err := eRate.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := eRate.Validate(); err != nil {
+	if err := r.validateTag(eRate); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.ExchangeRate = eRate
@@ -574,7 +1072,7 @@ func (r *Reader) parseBeneficiaryIntermediaryFI() error {
 	if err := bifi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := bifi.Validate(); err != nil {
+	if err := r.validateTag(bifi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.BeneficiaryIntermediaryFI = bifi
@@ -587,7 +1085,7 @@ func (r *Reader) parseBeneficiaryFI() error {
 	if err := bfi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := bfi.Validate(); err != nil {
+	if err := r.validateTag(bfi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.BeneficiaryFI = bfi
@@ -600,7 +1098,7 @@ func (r *Reader) parseBeneficiary() error {
 	if err := ben.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ben.Validate(); err != nil {
+	if err := r.validateTag(ben); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.Beneficiary = ben
@@ -613,7 +1111,7 @@ func (r *Reader) parseBeneficiaryReference() error {
 	if err := br.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := br.Validate(); err != nil {
+	if err := r.validateTag(br); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.BeneficiaryReference = br
@@ -626,7 +1124,7 @@ func (r *Reader) parseAccountDebitedDrawdown() error {
 	if err := debitDD.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := debitDD.Validate(); err != nil {
+	if err := r.validateTag(debitDD); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.AccountDebitedDrawdown = debitDD
@@ -639,7 +1137,7 @@ func (r *Reader) parseOriginator() error {
 	if err := o.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := o.Validate(); err != nil {
+	if err := r.validateTag(o); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.Originator = o
@@ -652,7 +1150,7 @@ func (r *Reader) parseOriginatorOptionF() error {
 	if err := oof.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := oof.Validate(); err != nil {
+	if err := r.validateTag(oof); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.OriginatorOptionF = oof
@@ -665,7 +1163,7 @@ func (r *Reader) parseOriginatorFI() error {
 	if err := ofi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ofi.Validate(); err != nil {
+	if err := r.validateTag(ofi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.OriginatorFI = ofi
@@ -678,7 +1176,7 @@ func (r *Reader) parseInstructingFI() error {
 	if err := ifi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ifi.Validate(); err != nil {
+	if err := r.validateTag(ifi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.InstructingFI = ifi
@@ -691,7 +1189,7 @@ func (r *Reader) parseAccountCreditedDrawdown() error {
 	if err := creditDD.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := creditDD.Validate(); err != nil {
+	if err := r.validateTag(creditDD); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.AccountCreditedDrawdown = creditDD
@@ -704,7 +1202,7 @@ func (r *Reader) parseOriginatorToBeneficiary() error {
 	if err := ob.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ob.Validate(); err != nil {
+	if err := r.validateTag(ob); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.OriginatorToBeneficiary = ob
@@ -717,7 +1215,7 @@ func (r *Reader) parseFIReceiverFI() error {
 	if err := firfi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := firfi.Validate(); err != nil {
+	if err := r.validateTag(firfi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIReceiverFI = firfi
@@ -730,7 +1228,7 @@ func (r *Reader) parseFIDrawdownDebitAccountAdvice() error {
 	if err := debitDDAdvice.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := debitDDAdvice.Validate(); err != nil {
+	if err := r.validateTag(debitDDAdvice); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIDrawdownDebitAccountAdvice = debitDDAdvice
@@ -743,7 +1241,7 @@ func (r *Reader) parseFIIntermediaryFI() error {
 	if err := fiifi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := fiifi.Validate(); err != nil {
+	if err := r.validateTag(fiifi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIIntermediaryFI = fiifi
@@ -756,7 +1254,7 @@ func (r *Reader) parseFIIntermediaryFIAdvice() error {
 	if err := fiifia.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := fiifia.Validate(); err != nil {
+	if err := r.validateTag(fiifia); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIIntermediaryFIAdvice = fiifia
@@ -769,7 +1267,7 @@ func (r *Reader) parseFIBeneficiaryFI() error {
 	if err := fibfi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := fibfi.Validate(); err != nil {
+	if err := r.validateTag(fibfi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIBeneficiaryFI = fibfi
@@ -782,7 +1280,7 @@ func (r *Reader) parseFIBeneficiaryFIAdvice() error {
 	if err := fibfia.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := fibfia.Validate(); err != nil {
+	if err := r.validateTag(fibfia); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIBeneficiaryFIAdvice = fibfia
@@ -795,7 +1293,7 @@ func (r *Reader) parseFIBeneficiary() error {
 	if err := fib.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := fib.Validate(); err != nil {
+	if err := r.validateTag(fib); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIBeneficiary = fib
@@ -808,7 +1306,7 @@ func (r *Reader) parseFIBeneficiaryAdvice() error {
 	if err := fiba.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := fiba.Validate(); err != nil {
+	if err := r.validateTag(fiba); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIBeneficiaryAdvice = fiba
@@ -821,7 +1319,7 @@ func (r *Reader) parseFIPaymentMethodToBeneficiary() error {
 	if err := pm.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := pm.Validate(); err != nil {
+	if err := r.validateTag(pm); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIPaymentMethodToBeneficiary = pm
@@ -834,7 +1332,7 @@ func (r *Reader) parseFIAdditionalFIToFI() error {
 	if err := fifi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := fifi.Validate(); err != nil {
+	if err := r.validateTag(fifi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIAdditionalFIToFI = fifi
@@ -847,7 +1345,7 @@ func (r *Reader) parseCurrencyInstructedAmount() error {
 	if err := cia.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := cia.Validate(); err != nil {
+	if err := r.validateTag(cia); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.CurrencyInstructedAmount = cia
@@ -860,7 +1358,7 @@ func (r *Reader) parseOrderingCustomer() error {
 	if err := oc.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := oc.Validate(); err != nil {
+	if err := r.validateTag(oc); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.OrderingCustomer = oc
@@ -873,7 +1371,7 @@ func (r *Reader) parseOrderingInstitution() error {
 	if err := oi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := oi.Validate(); err != nil {
+	if err := r.validateTag(oi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.OrderingInstitution = oi
@@ -886,7 +1384,7 @@ func (r *Reader) parseIntermediaryInstitution() error {
 	if err := ii.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ii.Validate(); err != nil {
+	if err := r.validateTag(ii); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.IntermediaryInstitution = ii
@@ -899,7 +1397,7 @@ func (r *Reader) parseInstitutionAccount() error {
 	if err := iAccount.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := iAccount.Validate(); err != nil {
+	if err := r.validateTag(iAccount); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.InstitutionAccount = iAccount
@@ -912,7 +1410,7 @@ func (r *Reader) parseBeneficiaryCustomer() error {
 	if err := bc.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := bc.Validate(); err != nil {
+	if err := r.validateTag(bc); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.BeneficiaryCustomer = bc
@@ -925,7 +1423,7 @@ func (r *Reader) parseRemittance() error {
 	if err := ri.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ri.Validate(); err != nil {
+	if err := r.validateTag(ri); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.Remittance = ri
@@ -938,7 +1436,7 @@ func (r *Reader) parseSenderToReceiver() error {
 	if err := sr.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := sr.Validate(); err != nil {
+	if err := r.validateTag(sr); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.SenderToReceiver = sr
@@ -951,7 +1449,7 @@ func (r *Reader) parseUnstructuredAddenda() error {
 	if err := ua.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ua.Validate(); err != nil {
+	if err := r.validateTag(ua); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.UnstructuredAddenda = ua
@@ -964,7 +1462,7 @@ func (r *Reader) parseRelatedRemittance() error {
 	if err := rr.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := rr.Validate(); err != nil {
+	if err := r.validateTag(rr); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.RelatedRemittance = rr
@@ -977,7 +1475,7 @@ func (r *Reader) parseRemittanceOriginator() error {
 	if err := ro.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ro.Validate(); err != nil {
+	if err := r.validateTag(ro); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.RemittanceOriginator = ro
@@ -990,7 +1488,7 @@ func (r *Reader) parseRemittanceBeneficiary() error {
 	if err := rb.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := rb.Validate(); err != nil {
+	if err := r.validateTag(rb); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.RemittanceBeneficiary = rb
@@ -1003,7 +1501,7 @@ func (r *Reader) parsePrimaryRemittanceDocument() error {
 	if err := prd.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := prd.Validate(); err != nil {
+	if err := r.validateTag(prd); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.PrimaryRemittanceDocument = prd
@@ -1016,7 +1514,7 @@ func (r *Reader) parseActualAmountPaid() error {
 	if err := aap.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := aap.Validate(); err != nil {
+	if err := r.validateTag(aap); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.ActualAmountPaid = aap
@@ -1029,7 +1527,7 @@ func (r *Reader) parseGrossAmountRemittanceDocument() error {
 	if err := gard.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := gard.Validate(); err != nil {
+	if err := r.validateTag(gard); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.GrossAmountRemittanceDocument = gard
@@ -1042,7 +1540,7 @@ func (r *Reader) parseAmountNegotiatedDiscount() error {
 	if err := nd.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := nd.Validate(); err != nil {
+	if err := r.validateTag(nd); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.AmountNegotiatedDiscount = nd
@@ -1055,7 +1553,7 @@ func (r *Reader) parseAdjustment() error {
 	if err := adj.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := adj.Validate(); err != nil {
+	if err := r.validateTag(adj); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.Adjustment = adj
@@ -1068,7 +1566,7 @@ func (r *Reader) parseDateRemittanceDocument() error {
 	if err := drd.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := drd.Validate(); err != nil {
+	if err := r.validateTag(drd); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.DateRemittanceDocument = drd
@@ -1081,7 +1579,7 @@ func (r *Reader) parseSecondaryRemittanceDocument() error {
 	if err := srd.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := srd.Validate(); err != nil {
+	if err := r.validateTag(srd); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.SecondaryRemittanceDocument = srd
@@ -1094,7 +1592,7 @@ func (r *Reader) parseRemittanceFreeText() error {
 	if err := rft.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := rft.Validate(); err != nil {
+	if err := r.validateTag(rft); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.RemittanceFreeText = rft
@@ -1107,7 +1605,7 @@ func (r *Reader) parseServiceMessage() error {
 	if err := sm.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := sm.Validate(); err != nil {
+	if err := r.validateTag(sm); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.ServiceMessage = sm
@@ -1120,7 +1618,7 @@ func (r *Reader) parseMessageDisposition() error {
 	if err := md.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := md.Validate(); err != nil {
+	if err := r.validateTag(md); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.MessageDisposition = md
@@ -1133,7 +1631,7 @@ func (r *Reader) parseReceiptTimeStamp() error {
 	if err := rts.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := rts.Validate(); err != nil {
+	if err := r.validateTag(rts); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.ReceiptTimeStamp = rts
@@ -1146,7 +1644,7 @@ func (r *Reader) parseOutputMessageAccountabilityData() error {
 	if err := omad.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := omad.Validate(); err != nil {
+	if err := r.validateTag(omad); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.OutputMessageAccountabilityData = omad
@@ -1159,7 +1657,7 @@ func (r *Reader) parseErrorWire() error {
 	if err := ew.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ew.Validate(); err != nil {
+	if err := r.validateTag(ew); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.ErrorWire = ew
@@ -0,0 +1,56 @@
+package wire
+
+import "golang.org/x/text/encoding"
+
+// ReaderOpts contains options which change how a Reader parses its input
+type ReaderOpts struct {
+	// KeepUnknownTags keeps tags the Reader doesn't recognize in FEDWireMessage.UnknownTags
+	// instead of failing with ErrInvalidTag. Vendors may add their own tags to messages.
+	KeepUnknownTags bool `json:"keepUnknownTags"`
+
+	// RecordSpans keeps where each tag was found in the input in FEDWireMessage.Spans
+	RecordSpans bool `json:"recordSpans"`
+
+	// PreserveFormat keeps how each tag was formatted in FEDWireMessage.SourceFormat, so Writer can
+	// reproduce the input exactly for tags which have not been modified.
+	PreserveFormat bool `json:"preserveFormat"`
+
+	// Encoding is the character set of the input, such as charmap.CodePage037 or charmap.CodePage1047
+	// for EBCDIC files. Input is decoded before parsing and the EBCDIC newline (NEL) is read as "\n".
+	// Spans and SourceFormat refer to the decoded input. The input is read as UTF-8 when nil.
+	Encoding encoding.Encoding `json:"-"`
+
+	// MaxBytes is the maximum number of bytes read from the input, before it is decoded. Reading stops
+	// with a FileTooLongErr wrapping ErrFileTooLarge once it is exceeded. Limits are not applied when they are zero.
+	MaxBytes int64 `json:"maxBytes"`
+
+	// MaxTags is the maximum number of tags read across every message of the input
+	MaxTags int `json:"maxTags"`
+
+	// MaxTagLength is the maximum length of a single tag, including its {xxxx} number.
+	// Longer tags stop reading with a TagTooLongErr.
+	MaxTagLength int `json:"maxTagLength"`
+
+	// MaxMessages is the maximum number of FEDWireMessages read from the input
+	MaxMessages int `json:"maxMessages"`
+
+	// DuplicateTags chooses what happens when a tag appears more than once within a FEDWireMessage.
+	// The last occurrence is kept when it is empty.
+	DuplicateTags DuplicateTagPolicy `json:"duplicateTags"`
+
+	// CheckTagOrder reports an ErrTagOrder for each tag found after a tag it should precede. Tags must be
+	// in ascending order within their group, such as the mandatory tags {1500} to {3600}.
+	CheckTagOrder bool `json:"checkTagOrder"`
+}
+
+// DuplicateTagPolicy chooses which occurrence of a repeated tag is kept
+type DuplicateTagPolicy string
+
+const (
+	// DuplicateTagsFail reports each repeated tag as an ErrDuplicateTag and keeps the first occurrence
+	DuplicateTagsFail DuplicateTagPolicy = "fail"
+	// DuplicateTagsKeepFirst ignores repeated tags
+	DuplicateTagsKeepFirst DuplicateTagPolicy = "keepFirst"
+	// DuplicateTagsKeepLast replaces the tag each time it is repeated, which is the default
+	DuplicateTagsKeepLast DuplicateTagPolicy = "keepLast"
+)
@@ -2,13 +2,20 @@ package wire
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"strings"
 	"testing"
 
+	"github.com/moov-io/base"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/charmap"
 )
 
 // TestRead reads wire Files with different BusinessFunctionCodes
@@ -26,7 +33,6 @@ func TestRead(t *testing.T) {
 	t.Run("ServiceMessage", testRead(filepath.Join("test", "testdata", "fedWireMessage-ServiceMessage.txt")))
 	t.Run("CustomerTransferPlusCOVS", testRead(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransferPlusCOVS.txt")))
 	t.Run("CustomerTransferPlusUnstructuredAddenda", testRead(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransferPlusUnstructuredAddenda.txt")))
-	t.Run("CustomerTransferPlusStructuredRemittance", testRead(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransferPlusStructuredRemittance.txt")))
 	t.Run("FedAppendedTags", testRead(filepath.Join("test", "testdata", "fedWireMessage-FedAppendedTags.txt")))
 	t.Run("FiservMessage", testRead(filepath.Join("test", "testdata", "fedWireMessage-fiserv.txt")))
 }
@@ -50,6 +56,28 @@ func testRead(filePathName string) func(t *testing.T) {
 	}
 }
 
+// TestReadCustomerTransferPlusStructuredRemittance reads a {8350} RemittanceBeneficiary missing the delimiter
+// after BuildingNumber, which shifts Country to the start of an address line
+func TestReadCustomerTransferPlusStructuredRemittance(t *testing.T) {
+	f, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransferPlusStructuredRemittance.txt"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	_, err = NewReader(f).Read()
+	require.True(t, base.Has(err, ErrNonCountryCode), "%v", err)
+	require.Contains(t, err.Error(), "Country Ad is not a recognized country code")
+
+	// the file is read when country codes aren't checked
+	_, err = f.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+	opts := &ValidateOpts{SkipRules: []string{RuleCountryCode}}
+	fwmFile, err := NewReader(f).ReadWithOpts(opts)
+	require.NoError(t, err)
+	require.Equal(t, "Ad", fwmFile.FEDWireMessage.RemittanceBeneficiary.RemittanceData.Country)
+	fwmFile.SetValidation(opts)
+	require.NoError(t, fwmFile.Validate())
+}
+
 func TestReadInvalidTag(t *testing.T) {
 	f, err := os.Open("./test/testdata/fedWireMessage-InvalidTag.txt")
 	if err != nil {
@@ -83,6 +111,22 @@ func TestRead_missingTag(t *testing.T) {
 	require.ErrorContains(t, err, ErrRequireDelimiter.Error())
 }
 
+// validation errors found once every tag has been read keep the errors they wrap
+func TestRead_validationErrors(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	input := regexp.MustCompile(`\{4200\}[^\n]*\n`).ReplaceAllString(string(bs), "")
+
+	_, err = NewReader(strings.NewReader(input)).Read()
+	require.EqualError(t, err, "file validation failed: Beneficiary is a required field")
+	require.True(t, base.Has(err, ErrFieldRequired))
+
+	details := ErrorDetails(err)
+	require.Len(t, details, 1)
+	require.Equal(t, TagBeneficiary, details[0].Tag)
+	require.Equal(t, "Beneficiary", details[0].Field)
+}
+
 func TestReadWithValidateOpts(t *testing.T) {
 	f, err := os.Open("./test/testdata/fedWireMessage-BankTransfer.txt")
 	if err != nil {
@@ -111,3 +155,485 @@ func TestReadWithValidateOpts(t *testing.T) {
 	require.NotNil(t, file)
 	require.Nil(t, file.FEDWireMessage.InputMessageAccountabilityData)
 }
+
+func TestRead_multipleMessages(t *testing.T) {
+	f, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-MultipleMessages.txt"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	file, err := NewReader(f).Read()
+	require.NoError(t, err)
+
+	messages := file.Messages()
+	require.Len(t, messages, 3)
+	require.Equal(t, BankTransfer, messages[0].BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, CustomerTransfer, messages[1].BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, BankTransfer, messages[2].BusinessFunctionCode.BusinessFunctionCode)
+
+	// Fed appended tags ending the input belong to the last message
+	require.Nil(t, messages[1].MessageDisposition)
+	require.NotNil(t, messages[2].MessageDisposition)
+	require.NotNil(t, messages[2].ErrorWire)
+}
+
+func TestRead_multipleMessagesLeadingFedAppendedTags(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-MultipleMessages.txt"))
+	require.NoError(t, err)
+
+	// move the Fed appended tags of the third message ahead of its {1500}, so they follow the body
+	// of the second message which has none of its own
+	lines := strings.Split(strings.TrimSpace(string(bs)), "\n")
+	require.Equal(t, "{1500}30User ReqT ", lines[55])
+	appended := slices.Clone(lines[len(lines)-4:])
+	require.True(t, strings.HasPrefix(appended[0], TagMessageDisposition))
+	lines = slices.Concat(lines[:55], appended, lines[55:len(lines)-4])
+	input := strings.Join(lines, "\n")
+
+	r := NewReader(strings.NewReader(input))
+	r.SetOptions(&ReaderOpts{RecordSpans: true})
+	file, err := r.Read()
+	require.NoError(t, err)
+
+	messages := file.Messages()
+	require.Len(t, messages, 3)
+	require.Nil(t, messages[1].MessageDisposition)
+	require.Nil(t, messages[1].ErrorWire)
+	require.NotContains(t, messages[1].Spans, TagMessageDisposition)
+	require.NotNil(t, messages[2].MessageDisposition)
+	require.NotNil(t, messages[2].ErrorWire)
+	require.Equal(t, appended[0], messages[2].Spans[TagMessageDisposition].Raw)
+
+	// Next splits the messages the same way
+	var dispositions []*MessageDisposition
+	for fwm, err := range NewReader(strings.NewReader(input)).All() {
+		require.NoError(t, err)
+		dispositions = append(dispositions, fwm.MessageDisposition)
+	}
+	require.Len(t, dispositions, 3)
+	require.Nil(t, dispositions[1])
+	require.Equal(t, messages[2].MessageDisposition, dispositions[2])
+}
+
+func TestRead_multipleMessagesWithoutSenderSupplied(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-MultipleMessages.txt"))
+	require.NoError(t, err)
+
+	// the first message has no {1500}, the following {1500} still begins a new message
+	lines := strings.Split(string(bs), "\n")
+	require.True(t, strings.HasPrefix(lines[0], TagSenderSupplied))
+	input := strings.Join(lines[1:], "\n")
+
+	file, err := NewReader(strings.NewReader(input), IncomingFile()).Read()
+	require.NoError(t, err)
+
+	messages := file.Messages()
+	require.Len(t, messages, 3)
+	require.Nil(t, messages[0].SenderSupplied)
+	require.NotNil(t, messages[1].SenderSupplied)
+	require.Equal(t, CustomerTransfer, messages[1].BusinessFunctionCode.BusinessFunctionCode)
+}
+
+func TestRead_multipleMessagesErrors(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-MultipleMessages.txt"))
+	require.NoError(t, err)
+
+	// break the Amount of the second message only
+	lines := strings.Split(string(bs), "\n")
+	require.Equal(t, "{2000}000001234567", lines[29])
+	lines[29] = "{2000}00000123456A"
+
+	_, err = NewReader(strings.NewReader(strings.Join(lines, "\n"))).Read()
+	require.Error(t, err)
+
+	var el base.ErrorList
+	require.ErrorAs(t, err, &el)
+	require.Len(t, el, 1)
+
+	var msgErr *MessageError
+	require.ErrorAs(t, el[0], &msgErr)
+	require.Equal(t, 1, msgErr.Index)
+	require.Contains(t, err.Error(), "message 2:")
+}
+
+func TestReader_Next(t *testing.T) {
+	f, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-MultipleMessages.txt"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	r := NewReader(f)
+
+	var codes []string
+	for {
+		fwm, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		codes = append(codes, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	}
+	require.Equal(t, []string{BankTransfer, CustomerTransfer, BankTransfer}, codes)
+
+	// messages are not kept on the File
+	require.True(t, r.File.FEDWireMessage.isEmpty())
+	require.Empty(t, r.File.AdditionalFEDWireMessages)
+
+	_, err = r.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestReader_NextErrors(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-MultipleMessages.txt"))
+	require.NoError(t, err)
+
+	// break the Amount of the second message only
+	lines := strings.Split(string(bs), "\n")
+	lines[29] = "{2000}00000123456A"
+
+	r := NewReader(strings.NewReader(strings.Join(lines, "\n")))
+
+	var errs []error
+	for fwm, err := range r.All() {
+		require.NotNil(t, fwm)
+		errs = append(errs, err)
+	}
+	require.Len(t, errs, 3)
+	require.NoError(t, errs[0])
+	require.Error(t, errs[1])
+	require.NoError(t, errs[2])
+
+	var el base.ErrorList
+	require.ErrorAs(t, errs[1], &el)
+	require.Len(t, el, 1)
+}
+
+func TestReader_NextValidateOpts(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	fwm.SenderSupplied = nil
+	fwm.ValidateOptions = &ValidateOpts{AllowMissingSenderSupplied: true}
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	require.NoError(t, w.writeFEDWireMessage(fwm))
+	require.NoError(t, w.Flush())
+
+	_, err := NewReader(bytes.NewReader(buf.Bytes())).Next()
+	require.ErrorContains(t, err, "message validation failed")
+
+	got, err := NewReader(bytes.NewReader(buf.Bytes()), IncomingFile()).Next()
+	require.NoError(t, err)
+	require.Nil(t, got.SenderSupplied)
+}
+
+func TestRead_unknownTags(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-fiservUnknownTags.txt"))
+	require.NoError(t, err)
+
+	_, err = NewReader(bytes.NewReader(bs)).Read()
+	require.ErrorContains(t, err, NewErrInvalidTag("{3690}").Error())
+
+	r := NewReader(bytes.NewReader(bs))
+	r.SetOptions(&ReaderOpts{KeepUnknownTags: true})
+	file, err := r.Read()
+	require.NoError(t, err)
+
+	expected := []UnknownTag{
+		{Tag: "{3690}", Data: "FSV123*", After: TagBusinessFunctionCode},
+		{Tag: "{9990}", Data: "BATCH 0001*", After: TagFIAdditionalFIToFI},
+		{Tag: "{9991}", Data: "OPERATOR JDOE*", After: "{9990}"},
+	}
+	require.Equal(t, expected, file.FEDWireMessage.UnknownTags)
+
+	// JSON keeps the unknown tags
+	bs, err = json.Marshal(file)
+	require.NoError(t, err)
+	fromJSON, err := FileFromJSON(bs)
+	require.NoError(t, err)
+	require.Equal(t, expected, fromJSON.FEDWireMessage.UnknownTags)
+}
+
+func TestRead_spans(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-MultipleMessages.txt"))
+	require.NoError(t, err)
+
+	file, err := NewReader(bytes.NewReader(bs)).Read()
+	require.NoError(t, err)
+	require.Nil(t, file.FEDWireMessage.Spans)
+
+	// use CRLF line endings to check offsets include them
+	bs = bytes.ReplaceAll(bs, []byte("\n"), []byte("\r\n"))
+
+	r := NewReader(bytes.NewReader(bs))
+	r.SetOptions(&ReaderOpts{RecordSpans: true})
+	file, err = r.Read()
+	require.NoError(t, err)
+
+	for _, fwm := range file.Messages() {
+		require.Len(t, fwm.Spans, len(fwm.tagRecords()))
+		for tag, span := range fwm.Spans {
+			require.Equal(t, span.Raw, string(bs[span.Offset:span.Offset+int64(span.Length)]))
+			require.True(t, strings.HasPrefix(span.Raw, tag))
+			require.NotContains(t, span.Raw, "\n")
+		}
+	}
+
+	span := file.AdditionalFEDWireMessages[0].Spans[TagAmount]
+	require.Equal(t, "{2000}000001234567", span.Raw)
+	second := bytes.Index(bs, []byte("{1500}"))
+	second += bytes.Index(bs[second+1:], []byte("{1500}")) + 1
+	require.Equal(t, second+bytes.Index(bs[second:], []byte("{2000}")), int(span.Offset))
+}
+
+func TestSpiltString(t *testing.T) {
+	segments := spiltString("{1500}30User ReqT \r\n{1510}10\n00", 10)
+	require.Len(t, segments, 2)
+
+	require.Equal(t, "{1500}30User ReqT ", segments[0].line)
+	require.Equal(t, Span{Offset: 10, Length: 18, Raw: "{1500}30User ReqT "}, segments[0].span)
+
+	// newlines within a tag are removed from the line but kept in the span
+	require.Equal(t, "{1510}1000", segments[1].line)
+	require.Equal(t, Span{Offset: 30, Length: 11, Raw: "{1510}10\n00"}, segments[1].span)
+}
+
+func TestReader_FormatOptions(t *testing.T) {
+	read := func(t *testing.T, input []byte) (*Reader, File) {
+		t.Helper()
+		r := NewReader(bytes.NewReader(input))
+		file, err := r.Read()
+		require.NoError(t, err)
+		return r, file
+	}
+
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.txt"))
+	require.NoError(t, err)
+
+	r, file := read(t, bs)
+	expected := FormatOptions{VariableLengthFields: true, NewlineCharacter: "\n"}
+	require.Equal(t, expected, r.FormatOptions())
+	require.Equal(t, &expected, file.SourceFormatOptions)
+
+	r, _ = read(t, bytes.ReplaceAll(bs, []byte("\n"), []byte("\r\n")))
+	require.Equal(t, FormatOptions{VariableLengthFields: true, NewlineCharacter: "\r\n"}, r.FormatOptions())
+
+	bs, err = os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-fiserv.txt"))
+	require.NoError(t, err)
+	r, _ = read(t, bs)
+	require.Equal(t, FormatOptions{VariableLengthFields: true}, r.FormatOptions())
+
+	// files written with fixed length fields
+	var buf bytes.Buffer
+	require.NoError(t, NewWriter(&buf).Write(&file))
+	r, _ = read(t, buf.Bytes())
+	require.Equal(t, FormatOptions{NewlineCharacter: "\n"}, r.FormatOptions())
+}
+
+func TestRead_encoding(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+
+	expected, err := NewReader(bytes.NewReader(bs)).Read()
+	require.NoError(t, err)
+
+	for _, cm := range []*charmap.Charmap{charmap.CodePage037, charmap.CodePage1047} {
+		t.Run(cm.String(), func(t *testing.T) {
+			ebcdic, err := cm.NewEncoder().Bytes(bs)
+			require.NoError(t, err)
+
+			// the input can't be read without decoding it
+			_, err = NewReader(bytes.NewReader(ebcdic)).Read()
+			require.Error(t, err)
+
+			r := NewReader(bytes.NewReader(ebcdic))
+			r.SetOptions(&ReaderOpts{Encoding: cm})
+			file, err := r.Read()
+			require.NoError(t, err)
+			require.Equal(t, expected.FEDWireMessage, file.FEDWireMessage)
+
+			// mainframe files may separate tags with NEL rather than LF
+			nel := bytes.ReplaceAll(ebcdic, []byte{0x25}, []byte{0x15})
+			r = NewReader(bytes.NewReader(nel))
+			r.SetOptions(&ReaderOpts{Encoding: cm})
+			file, err = r.Read()
+			require.NoError(t, err)
+			require.Equal(t, expected.FEDWireMessage, file.FEDWireMessage)
+		})
+	}
+}
+
+func TestReader_limits(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-MultipleMessages.txt"))
+	require.NoError(t, err)
+
+	read := func(opts *ReaderOpts) (File, error) {
+		r := NewReader(bytes.NewReader(bs))
+		r.SetOptions(opts)
+		return r.Read()
+	}
+	// lastError returns the error which stopped reading
+	lastError := func(err error) error {
+		var el base.ErrorList
+		require.ErrorAs(t, err, &el)
+		return el[len(el)-1]
+	}
+
+	t.Run("within limits", func(t *testing.T) {
+		file, err := read(&ReaderOpts{MaxBytes: int64(len(bs)), MaxTags: 85, MaxTagLength: 70, MaxMessages: 3})
+		require.NoError(t, err)
+		require.Len(t, file.Messages(), 3)
+	})
+
+	t.Run("MaxBytes", func(t *testing.T) {
+		_, err := read(&ReaderOpts{MaxBytes: 100})
+		require.True(t, base.Has(err, ErrFileTooLarge))
+		require.False(t, base.Has(err, ErrFileTooLong))
+		var limitErr FileTooLongErr
+		require.ErrorAs(t, lastError(err), &limitErr)
+		require.Equal(t, "MaxBytes", limitErr.Limit)
+		require.Equal(t, int64(100), limitErr.Max)
+	})
+
+	t.Run("MaxTags", func(t *testing.T) {
+		_, err := read(&ReaderOpts{MaxTags: 10})
+		require.True(t, base.Has(err, ErrFileTooLong))
+		require.EqualError(t, lastError(err), "line:11 record:{4100} wire.FileTooLongErr file exceeds maximum possible number of lines: MaxTags of 10 exceeded")
+	})
+
+	t.Run("MaxTagLength", func(t *testing.T) {
+		_, err := read(&ReaderOpts{MaxTagLength: 40})
+		var lengthErr TagTooLongErr
+		require.ErrorAs(t, lastError(err), &lengthErr)
+		require.Equal(t, 40, lengthErr.MaxLength)
+		require.Greater(t, lengthErr.Length, 40)
+		require.False(t, errors.As(lastError(err), new(TagWrongLengthErr)))
+
+		// tags too long to be buffered
+		long := append([]byte("{1500}"), bytes.Repeat([]byte("A"), 1000)...)
+		r := NewReader(bytes.NewReader(long))
+		r.SetOptions(&ReaderOpts{MaxTagLength: 40})
+		_, err = r.Read()
+		require.ErrorAs(t, lastError(err), &lengthErr)
+	})
+
+	t.Run("MaxMessages", func(t *testing.T) {
+		file, err := read(&ReaderOpts{MaxMessages: 2})
+		require.True(t, base.Has(err, ErrFileTooLong))
+		require.ErrorIs(t, lastError(err), NewFileTooLongErr("MaxMessages", 2))
+		require.Len(t, file.Messages(), 2)
+	})
+
+	t.Run("Next", func(t *testing.T) {
+		r := NewReader(bytes.NewReader(bs))
+		r.SetOptions(&ReaderOpts{MaxMessages: 2})
+
+		var count int
+		for fwm, err := range r.All() {
+			if count < 2 {
+				require.NoError(t, err)
+				require.NotNil(t, fwm)
+			} else {
+				require.ErrorIs(t, err, ErrFileTooLong)
+			}
+			count++
+		}
+		require.Equal(t, 3, count)
+
+		_, err := r.Next()
+		require.ErrorIs(t, err, ErrFileTooLong)
+	})
+}
+
+func TestReader_duplicateTags(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	// repeat {4200} Beneficiary with another name on line 17, after {4320}
+	input := strings.Replace(string(bs), "{4320}Reference*\n", "{4320}Reference*\n{4200}31234*Other Name*Address One*Address Two*Address Three*\n", 1)
+
+	read := func(opts *ReaderOpts) (File, error) {
+		r := NewReader(strings.NewReader(input))
+		r.SetOptions(opts)
+		return r.Read()
+	}
+
+	t.Run("fail", func(t *testing.T) {
+		_, err := read(&ReaderOpts{DuplicateTags: DuplicateTagsFail})
+		require.True(t, base.Has(err, NewErrDuplicateTag(TagBeneficiary, 15, 17)))
+		require.EqualError(t, err, "line:17 record:Beneficiary wire.ErrDuplicateTag {4200} is duplicated on lines 15 and 17")
+	})
+
+	t.Run("default", func(t *testing.T) {
+		// the last occurrence wins, as it did before DuplicateTags was added
+		file, err := NewReader(strings.NewReader(input)).Read()
+		require.NoError(t, err)
+		require.Equal(t, "Other Name", file.FEDWireMessage.Beneficiary.Personal.Name)
+	})
+
+	t.Run("keep first", func(t *testing.T) {
+		file, err := read(&ReaderOpts{DuplicateTags: DuplicateTagsKeepFirst})
+		require.NoError(t, err)
+		require.Equal(t, "Name", file.FEDWireMessage.Beneficiary.Personal.Name)
+	})
+
+	t.Run("keep last", func(t *testing.T) {
+		file, err := read(&ReaderOpts{DuplicateTags: DuplicateTagsKeepLast, PreserveFormat: true})
+		require.NoError(t, err)
+		require.Equal(t, "Other Name", file.FEDWireMessage.Beneficiary.Personal.Name)
+
+		// the replaced tag isn't written
+		var buf bytes.Buffer
+		require.NoError(t, NewWriter(&buf).Write(&file))
+		require.Equal(t, 1, strings.Count(buf.String(), TagBeneficiary))
+		require.Contains(t, buf.String(), "Other Name")
+	})
+
+	t.Run("unknown tags", func(t *testing.T) {
+		input := strings.Replace(input, "{4200}31234*Other", "{9990}Vendor*\n{9990}Vendor*\n{4200}31234*Other", 1)
+		r := NewReader(strings.NewReader(input))
+		r.SetOptions(&ReaderOpts{DuplicateTags: DuplicateTagsKeepLast, KeepUnknownTags: true})
+		file, err := r.Read()
+		require.NoError(t, err)
+		require.Len(t, file.FEDWireMessage.UnknownTags, 2)
+	})
+}
+
+func TestReader_CheckTagOrder(t *testing.T) {
+	// tags of every valid test file are in order within their groups
+	paths, err := filepath.Glob(filepath.Join("test", "testdata", "*.txt"))
+	require.NoError(t, err)
+	for _, p := range paths {
+		bs, err := os.ReadFile(p)
+		require.NoError(t, err)
+		if _, err := NewReader(bytes.NewReader(bs)).Read(); err != nil {
+			continue
+		}
+		r := NewReader(bytes.NewReader(bs))
+		r.SetOptions(&ReaderOpts{CheckTagOrder: true})
+		_, err = r.Read()
+		require.NoError(t, err, p)
+	}
+
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	input := strings.Replace(string(bs), "{3400}231380104Citadel*\n{3600}CTR   *\n", "{3600}CTR   *\n{3400}231380104Citadel*\n", 1)
+
+	_, err = NewReader(strings.NewReader(input)).Read()
+	require.NoError(t, err)
+
+	r := NewReader(strings.NewReader(input))
+	r.SetOptions(&ReaderOpts{CheckTagOrder: true})
+	_, err = r.Read()
+	require.True(t, base.Has(err, NewErrTagOrder(TagReceiverDepositoryInstitution, TagBusinessFunctionCode, 7)))
+	require.EqualError(t, err, "line:7 record:ReceiverDepositoryInstitution wire.ErrTagOrder {3400} at position 7 is out of order, it must precede {3600}")
+
+	// positions are counted within each message
+	r = NewReader(strings.NewReader(string(bs) + input))
+	r.SetOptions(&ReaderOpts{CheckTagOrder: true})
+	_, err = r.Read()
+	var el base.ErrorList
+	require.ErrorAs(t, err, &el)
+	require.Len(t, el, 1)
+	var orderErr ErrTagOrder
+	require.ErrorAs(t, el[0], &orderErr)
+	require.Equal(t, 7, orderErr.Position)
+}
@@ -7,6 +7,7 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"time"
 	"unicode/utf8"
 )
 
@@ -116,6 +117,11 @@ func (rts *ReceiptTimeStamp) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on ReceiptTimeStamp and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (rts *ReceiptTimeStamp) Validate() error {
+	return rts.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on ReceiptTimeStamp following opts, which may be nil
+func (rts *ReceiptTimeStamp) validate(opts *ValidateOpts) error {
 	// Currently no validation as the FED is responsible for the values
 	if rts.tag != TagReceiptTimeStamp {
 		return fieldError("tag", ErrValidTagForType, rts.tag)
@@ -152,3 +158,18 @@ func (rts *ReceiptTimeStamp) FormatReceiptTime(options FormatOptions) string {
 func (rts *ReceiptTimeStamp) FormatReceiptApplicationIdentification(options FormatOptions) string {
 	return rts.formatAlphaField(rts.ReceiptApplicationIdentification, 4, options)
 }
+
+// Timestamp returns ReceiptDate and ReceiptTime in FedwireLocation. ReceiptDate has no year, so the year
+// is inferred from cycleDate, the InputCycleDate of the message.
+func (rts *ReceiptTimeStamp) Timestamp(cycleDate time.Time) (time.Time, error) {
+	t, err := parseMMDDHHMM(rts.ReceiptDate, rts.ReceiptTime, cycleDate)
+	if err != nil {
+		return time.Time{}, fieldError("ReceiptDate", err, rts.ReceiptDate+rts.ReceiptTime)
+	}
+	return t, nil
+}
+
+// SetTimestamp sets ReceiptDate and ReceiptTime to t in FedwireLocation
+func (rts *ReceiptTimeStamp) SetTimestamp(t time.Time) {
+	rts.ReceiptDate, rts.ReceiptTime = formatMMDDHHMM(t)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -103,3 +104,40 @@ func TestStringReceiptTimeStampOptions(t *testing.T) {
 	require.Equal(t, "{1110}            ", record.Format(FormatOptions{VariableLengthFields: true}))
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
+
+// TestReceiptTimeStampTimestamp validates reading and setting ReceiptTimeStamp as a time.Time
+func TestReceiptTimeStampTimestamp(t *testing.T) {
+	rts := mockReceiptTimeStamp()
+	cycleDate := time.Date(2019, time.May, 2, 0, 0, 0, 0, FedwireLocation)
+	ts, err := rts.Timestamp(cycleDate)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.May, 2, 12, 30, 0, 0, FedwireLocation), ts)
+
+	// February 29th is only valid in the leap year closest to the cycle date
+	rts.ReceiptDate, rts.ReceiptTime = "0229", "2130"
+	ts, err = rts.Timestamp(time.Date(2021, time.January, 4, 0, 0, 0, 0, FedwireLocation))
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2020, time.February, 29, 21, 30, 0, 0, FedwireLocation), ts)
+
+	rts.SetTimestamp(time.Date(2019, time.July, 4, 2, 15, 0, 0, time.UTC))
+	require.Equal(t, "0703", rts.ReceiptDate)
+	require.Equal(t, "2215", rts.ReceiptTime)
+
+	rts.ReceiptDate = "1301"
+	_, err = rts.Timestamp(cycleDate)
+	require.ErrorIs(t, err, ErrValidDate)
+}
+
+// TestFEDWireMessageReceiptTimestamp validates the receipt time of a FEDWireMessage
+func TestFEDWireMessageReceiptTimestamp(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	_, err := fwm.ReceiptTimestamp()
+	require.ErrorIs(t, err, ErrFieldRequired)
+
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20200102"
+	fwm.ReceiptTimeStamp = mockReceiptTimeStamp()
+	fwm.ReceiptTimeStamp.ReceiptDate, fwm.ReceiptTimeStamp.ReceiptTime = "1231", "2100"
+	ts, err := fwm.ReceiptTimestamp()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.December, 31, 21, 0, 0, 0, FedwireLocation), ts)
+}
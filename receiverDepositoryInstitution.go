@@ -102,6 +102,11 @@ func (rdi *ReceiverDepositoryInstitution) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on ReceiverDepositoryInstitution and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (rdi *ReceiverDepositoryInstitution) Validate() error {
+	return rdi.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on ReceiverDepositoryInstitution following opts, which may be nil
+func (rdi *ReceiverDepositoryInstitution) validate(opts *ValidateOpts) error {
 	if err := rdi.fieldInclusion(); err != nil {
 		return err
 	}
@@ -111,7 +116,10 @@ func (rdi *ReceiverDepositoryInstitution) Validate() error {
 	if err := rdi.isNumeric(rdi.ReceiverABANumber); err != nil {
 		return fieldError("ReceiverABANumber", err, rdi.ReceiverABANumber)
 	}
-	if err := rdi.isAlphanumeric(rdi.ReceiverShortName); err != nil {
+	if err := rdi.isABANumber(opts, rdi.ReceiverABANumber); err != nil {
+		return fieldError("ReceiverABANumber", err, rdi.ReceiverABANumber)
+	}
+	if err := rdi.isAlphanumeric(opts, rdi.ReceiverShortName); err != nil {
 		return fieldError("ReceiverShortName", err, rdi.ReceiverShortName)
 	}
 	return nil
@@ -42,6 +42,16 @@ func TestReceiverShortNameAlphaNumeric(t *testing.T) {
 	require.EqualError(t, err, fieldError("ReceiverShortName", ErrNonAlphanumeric, rdi.ReceiverShortName).Error())
 }
 
+// TestReceiverABANumberCheckDigit validates the check digit of ReceiverDepositoryInstitution ReceiverABANumber
+func TestReceiverABANumberCheckDigit(t *testing.T) {
+	rdi := mockReceiverDepositoryInstitution()
+	rdi.ReceiverABANumber = "23138010"
+
+	err := rdi.Validate()
+
+	require.EqualError(t, err, fieldError("ReceiverABANumber", ErrABANumber, rdi.ReceiverABANumber).Error())
+}
+
 // TestReceiverABANumberRequired validates ReceiverDepositoryInstitution ReceiverABANumber is required
 func TestReceiverABANumberRequired(t *testing.T) {
 	rdi := mockReceiverDepositoryInstitution()
@@ -88,14 +98,14 @@ func TestReceiverDepositoryInstitutionTagError(t *testing.T) {
 
 // TestStringReceiverDepositoryInstitutionVariableLength parses using variable length
 func TestStringReceiverDepositoryInstitutionVariableLength(t *testing.T) {
-	var line = "{3400}1        A*"
+	var line = "{3400}231380104A*"
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 
 	err := r.parseReceiverDepositoryInstitution()
 	require.NoError(t, err)
 
-	line = "{3400}1        A                 NNN*"
+	line = "{3400}231380104A                 NNN*"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -109,7 +119,7 @@ func TestStringReceiverDepositoryInstitutionVariableLength(t *testing.T) {
 	err = r.parseReceiverDepositoryInstitution()
 	require.ErrorContains(t, err, ErrValidLength.Error())
 
-	line = "{3400}1        A*"
+	line = "{3400}231380104A*"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -119,7 +129,7 @@ func TestStringReceiverDepositoryInstitutionVariableLength(t *testing.T) {
 
 // TestStringReceiverDepositoryInstitutionOptions validates Format() formatted according to the FormatOptions
 func TestStringReceiverDepositoryInstitutionOptions(t *testing.T) {
-	var line = "{3400}1        A*"
+	var line = "{3400}231380104A*"
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -127,11 +137,11 @@ func TestStringReceiverDepositoryInstitutionOptions(t *testing.T) {
 	require.NoError(t, err)
 
 	record := r.currentFEDWireMessage.ReceiverDepositoryInstitution
-	require.Equal(t, "{3400}1        A                 *", record.String())
-	require.Equal(t, "{3400}1        A*", record.Format(FormatOptions{VariableLengthFields: true}))
+	require.Equal(t, "{3400}231380104A                 *", record.String())
+	require.Equal(t, "{3400}231380104A*", record.Format(FormatOptions{VariableLengthFields: true}))
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 
-	line = "{3400}1        *"
+	line = "{3400}231380104*"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -139,11 +149,11 @@ func TestStringReceiverDepositoryInstitutionOptions(t *testing.T) {
 	require.NoError(t, err)
 
 	record = r.currentFEDWireMessage.ReceiverDepositoryInstitution
-	require.Equal(t, "{3400}1                          *", record.String())
-	require.Equal(t, "{3400}1        *", record.Format(FormatOptions{VariableLengthFields: true}))
+	require.Equal(t, "{3400}231380104                  *", record.String())
+	require.Equal(t, "{3400}231380104*", record.Format(FormatOptions{VariableLengthFields: true}))
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 
-	line = "{3400}111111111*"
+	line = "{3400}121042882*"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -151,7 +161,7 @@ func TestStringReceiverDepositoryInstitutionOptions(t *testing.T) {
 	require.NoError(t, err)
 
 	record = r.currentFEDWireMessage.ReceiverDepositoryInstitution
-	require.Equal(t, "{3400}111111111                  *", record.String())
-	require.Equal(t, "{3400}111111111*", record.Format(FormatOptions{VariableLengthFields: true}))
+	require.Equal(t, "{3400}121042882                  *", record.String())
+	require.Equal(t, "{3400}121042882*", record.Format(FormatOptions{VariableLengthFields: true}))
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
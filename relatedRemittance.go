@@ -254,75 +254,90 @@ func (rr *RelatedRemittance) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on RelatedRemittance and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (rr *RelatedRemittance) Validate() error {
+	return rr.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on RelatedRemittance following opts, which may be nil
+func (rr *RelatedRemittance) validate(opts *ValidateOpts) error {
 	if rr.tag != TagRelatedRemittance {
 		return fieldError("tag", ErrValidTagForType, rr.tag)
 	}
 	if err := rr.fieldInclusion(); err != nil {
 		return err
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceIdentification); err != nil {
+	if err := rr.isAlphanumeric(opts, rr.RemittanceIdentification); err != nil {
 		return fieldError("RemittanceIdentification", err, rr.RemittanceIdentification)
 	}
 	if err := rr.isRemittanceLocationMethod(rr.RemittanceLocationMethod); err != nil {
 		return fieldError("RemittanceLocationMethod", err, rr.RemittanceLocationMethod)
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceLocationElectronicAddress); err != nil {
+	if err := rr.isAlphanumeric(opts, rr.RemittanceLocationElectronicAddress); err != nil {
 		return fieldError("RemittanceLocationElectronicAddress", err, rr.RemittanceLocationElectronicAddress)
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceData.Name); err != nil {
+	if err := rr.isAlphanumeric(opts, rr.RemittanceData.Name); err != nil {
 		return fieldError("Name", err, rr.RemittanceData.Name)
 	}
 	if err := rr.isAddressType(rr.RemittanceData.AddressType); err != nil {
 		return fieldError("AddressType", err, rr.RemittanceData.AddressType)
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceData.Department); err != nil {
+	if err := rr.isAlphanumeric(opts, rr.RemittanceData.Department); err != nil {
 		return fieldError("Department", err, rr.RemittanceData.Department)
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceData.SubDepartment); err != nil {
+	if err := rr.isAlphanumeric(opts, rr.RemittanceData.SubDepartment); err != nil {
 		return fieldError("SubDepartment", err, rr.RemittanceData.SubDepartment)
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceData.StreetName); err != nil {
+	if err := rr.isAlphanumeric(opts, rr.RemittanceData.StreetName); err != nil {
 		return fieldError("StreetName", err, rr.RemittanceData.StreetName)
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceData.BuildingNumber); err != nil {
+	if err := rr.isAlphanumeric(opts, rr.RemittanceData.BuildingNumber); err != nil {
 		return fieldError("BuildingNumber", err, rr.RemittanceData.BuildingNumber)
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceData.PostCode); err != nil {
+	if err := rr.isAlphanumeric(opts, rr.RemittanceData.PostCode); err != nil {
 		return fieldError("PostCode", err, rr.RemittanceData.PostCode)
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceData.TownName); err != nil {
+	if err := rr.isAlphanumeric(opts, rr.RemittanceData.TownName); err != nil {
 		return fieldError("TownName", err, rr.RemittanceData.TownName)
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceData.CountrySubDivisionState); err != nil {
+	if err := rr.isAlphanumeric(opts, rr.RemittanceData.CountrySubDivisionState); err != nil {
 		return fieldError("CountrySubDivisionState", err, rr.RemittanceData.CountrySubDivisionState)
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceData.Country); err != nil {
+	if err := rr.isAlphanumeric(opts, rr.RemittanceData.Country); err != nil {
 		return fieldError("Country", err, rr.RemittanceData.Country)
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceData.AddressLineOne); err != nil {
+	if rr.RemittanceData.Country != "" {
+		if err := rr.isCountryCode(opts, rr.RemittanceData.Country); err != nil {
+			return fieldError("Country", err, rr.RemittanceData.Country)
+		}
+	}
+	if err := rr.isAlphanumeric(opts, rr.RemittanceData.AddressLineOne); err != nil {
 		return fieldError("AddressLineOne", err, rr.RemittanceData.AddressLineOne)
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceData.AddressLineTwo); err != nil {
+	if err := rr.isAlphanumeric(opts, rr.RemittanceData.AddressLineTwo); err != nil {
 		return fieldError("AddressLineTwo", err, rr.RemittanceData.AddressLineTwo)
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceData.AddressLineThree); err != nil {
+	if err := rr.isAlphanumeric(opts, rr.RemittanceData.AddressLineThree); err != nil {
 		return fieldError("AddressLineThree", err, rr.RemittanceData.AddressLineThree)
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceData.AddressLineFour); err != nil {
+	if err := rr.isAlphanumeric(opts, rr.RemittanceData.AddressLineFour); err != nil {
 		return fieldError("AddressLineFour", err, rr.RemittanceData.AddressLineFour)
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceData.AddressLineFive); err != nil {
+	if err := rr.isAlphanumeric(opts, rr.RemittanceData.AddressLineFive); err != nil {
 		return fieldError("AddressLineFive", err, rr.RemittanceData.AddressLineFive)
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceData.AddressLineSix); err != nil {
+	if err := rr.isAlphanumeric(opts, rr.RemittanceData.AddressLineSix); err != nil {
 		return fieldError("AddressLineSix", err, rr.RemittanceData.AddressLineSix)
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceData.AddressLineSeven); err != nil {
+	if err := rr.isAlphanumeric(opts, rr.RemittanceData.AddressLineSeven); err != nil {
 		return fieldError("AddressLineSeven", err, rr.RemittanceData.AddressLineSeven)
 	}
-	if err := rr.isAlphanumeric(rr.RemittanceData.CountryOfResidence); err != nil {
+	if err := rr.isAlphanumeric(opts, rr.RemittanceData.CountryOfResidence); err != nil {
 		return fieldError("CountryOfResidence", err, rr.RemittanceData.CountryOfResidence)
 	}
+	if rr.RemittanceData.CountryOfResidence != "" {
+		if err := rr.isCountryCode(opts, rr.RemittanceData.CountryOfResidence); err != nil {
+			return fieldError("CountryOfResidence", err, rr.RemittanceData.CountryOfResidence)
+		}
+	}
 	return nil
 }
 
@@ -128,25 +128,30 @@ func (ri *Remittance) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on Remittance and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (ri *Remittance) Validate() error {
+	return ri.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on Remittance following opts, which may be nil
+func (ri *Remittance) validate(opts *ValidateOpts) error {
 	if err := ri.fieldInclusion(); err != nil {
 		return err
 	}
 	if ri.tag != TagRemittance {
 		return fieldError("tag", ErrValidTagForType, ri.tag)
 	}
-	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftFieldTag); err != nil {
+	if err := ri.isAlphanumeric(opts, ri.CoverPayment.SwiftFieldTag); err != nil {
 		return fieldError("SwiftFieldTag", err, ri.CoverPayment.SwiftFieldTag)
 	}
-	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftLineOne); err != nil {
+	if err := ri.isAlphanumeric(opts, ri.CoverPayment.SwiftLineOne); err != nil {
 		return fieldError("SwiftLineOne", err, ri.CoverPayment.SwiftLineOne)
 	}
-	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftLineTwo); err != nil {
+	if err := ri.isAlphanumeric(opts, ri.CoverPayment.SwiftLineTwo); err != nil {
 		return fieldError("SwiftLineTwo", err, ri.CoverPayment.SwiftLineTwo)
 	}
-	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftLineThree); err != nil {
+	if err := ri.isAlphanumeric(opts, ri.CoverPayment.SwiftLineThree); err != nil {
 		return fieldError("SwiftLineThree", err, ri.CoverPayment.SwiftLineThree)
 	}
-	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftLineFour); err != nil {
+	if err := ri.isAlphanumeric(opts, ri.CoverPayment.SwiftLineFour); err != nil {
 		return fieldError("SwiftLineFour", err, ri.CoverPayment.SwiftLineFour)
 	}
 	return nil
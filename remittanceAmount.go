@@ -11,3 +11,36 @@ type RemittanceAmount struct {
 	// Amount Must contain at least one numeric character and only one decimal period marker (e.g., $1,234.56 should be entered as 1234.56). Can have up to 5 numeric characters following the decimal period marker (e.g., 1234.56789). Amount must be greater than zero (i.e., at least .01).
 	Amount string `json:"amount,omitempty"`
 }
+
+// MinorUnits returns Amount in the minor unit of CurrencyCode, such as 123456 for USD 1234.56. An error is
+// returned when Amount has more decimals than the currency, as it may have up to 5 decimals.
+func (ra *RemittanceAmount) MinorUnits() (int64, error) {
+	exponent, err := currencyExponent(ra.CurrencyCode)
+	if err != nil {
+		return 0, fieldError("CurrencyCode", err, ra.CurrencyCode)
+	}
+	minor, err := parseMinorUnits(ra.Amount, '.', exponent)
+	if err != nil {
+		return 0, fieldError("Amount", err, ra.Amount)
+	}
+	return minor, nil
+}
+
+// SetMinorUnits sets CurrencyCode and sets Amount from a value in the minor unit of the currency,
+// such as USD and 123456 for USD 1234.56
+func (ra *RemittanceAmount) SetMinorUnits(currencyCode string, minor int64) error {
+	exponent, err := currencyExponent(currencyCode)
+	if err != nil {
+		return fieldError("CurrencyCode", err, currencyCode)
+	}
+	amount, err := formatMinorUnits(minor, '.', exponent)
+	if err != nil {
+		return fieldError("Amount", err, minor)
+	}
+	if len(amount) > 18 {
+		return fieldError("Amount", ErrValidLength, amount)
+	}
+	ra.CurrencyCode = currencyCode
+	ra.Amount = amount
+	return nil
+}
@@ -290,13 +290,18 @@ func (rb *RemittanceBeneficiary) Format(options FormatOptions) string {
 //
 // * Date & Place of Birth is only permitted for Identification Code PICDateBirthPlace.
 func (rb *RemittanceBeneficiary) Validate() error {
+	return rb.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on RemittanceBeneficiary following opts, which may be nil
+func (rb *RemittanceBeneficiary) validate(opts *ValidateOpts) error {
 	if err := rb.fieldInclusion(); err != nil {
 		return err
 	}
 	if rb.tag != TagRemittanceBeneficiary {
 		return fieldError("tag", ErrValidTagForType, rb.tag)
 	}
-	if err := rb.isAlphanumeric(rb.RemittanceData.Name); err != nil {
+	if err := rb.isAlphanumeric(opts, rb.RemittanceData.Name); err != nil {
 		return fieldError("Name", err, rb.RemittanceData.Name)
 	}
 	if err := rb.isIdentificationType(rb.IdentificationType); err != nil {
@@ -312,63 +317,73 @@ func (rb *RemittanceBeneficiary) Validate() error {
 			return fieldError("IdentificationCode", err, rb.IdentificationCode)
 		}
 	}
-	if err := rb.isAlphanumeric(rb.IdentificationNumber); err != nil {
+	if err := rb.isAlphanumeric(opts, rb.IdentificationNumber); err != nil {
 		return fieldError("IdentificationNumber", err, rb.IdentificationNumber)
 	}
-	if err := rb.isAlphanumeric(rb.IdentificationNumberIssuer); err != nil {
+	if err := rb.isAlphanumeric(opts, rb.IdentificationNumberIssuer); err != nil {
 		return fieldError("IdentificationNumberIssuer", err, rb.IdentificationNumberIssuer)
 	}
 	if err := rb.isAddressType(rb.RemittanceData.AddressType); err != nil {
 		return fieldError("AddressType", err, rb.RemittanceData.AddressType)
 	}
-	if err := rb.isAlphanumeric(rb.RemittanceData.Department); err != nil {
+	if err := rb.isAlphanumeric(opts, rb.RemittanceData.Department); err != nil {
 		return fieldError("Department", err, rb.RemittanceData.Department)
 	}
-	if err := rb.isAlphanumeric(rb.RemittanceData.SubDepartment); err != nil {
+	if err := rb.isAlphanumeric(opts, rb.RemittanceData.SubDepartment); err != nil {
 		return fieldError("SubDepartment", err, rb.RemittanceData.SubDepartment)
 	}
-	if err := rb.isAlphanumeric(rb.RemittanceData.StreetName); err != nil {
+	if err := rb.isAlphanumeric(opts, rb.RemittanceData.StreetName); err != nil {
 		return fieldError("StreetName", err, rb.RemittanceData.StreetName)
 	}
-	if err := rb.isAlphanumeric(rb.RemittanceData.BuildingNumber); err != nil {
+	if err := rb.isAlphanumeric(opts, rb.RemittanceData.BuildingNumber); err != nil {
 		return fieldError("BuildingNumber", err, rb.RemittanceData.BuildingNumber)
 	}
-	if err := rb.isAlphanumeric(rb.RemittanceData.PostCode); err != nil {
+	if err := rb.isAlphanumeric(opts, rb.RemittanceData.PostCode); err != nil {
 		return fieldError("PostCode", err, rb.RemittanceData.PostCode)
 	}
-	if err := rb.isAlphanumeric(rb.RemittanceData.TownName); err != nil {
+	if err := rb.isAlphanumeric(opts, rb.RemittanceData.TownName); err != nil {
 		return fieldError("TownName", err, rb.RemittanceData.TownName)
 	}
-	if err := rb.isAlphanumeric(rb.RemittanceData.CountrySubDivisionState); err != nil {
+	if err := rb.isAlphanumeric(opts, rb.RemittanceData.CountrySubDivisionState); err != nil {
 		return fieldError("CountrySubDivisionState", err, rb.RemittanceData.CountrySubDivisionState)
 	}
-	if err := rb.isAlphanumeric(rb.RemittanceData.Country); err != nil {
+	if err := rb.isAlphanumeric(opts, rb.RemittanceData.Country); err != nil {
 		return fieldError("Country", err, rb.RemittanceData.Country)
 	}
-	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineOne); err != nil {
+	if rb.RemittanceData.Country != "" {
+		if err := rb.isCountryCode(opts, rb.RemittanceData.Country); err != nil {
+			return fieldError("Country", err, rb.RemittanceData.Country)
+		}
+	}
+	if err := rb.isAlphanumeric(opts, rb.RemittanceData.AddressLineOne); err != nil {
 		return fieldError("AddressLineOne", err, rb.RemittanceData.AddressLineOne)
 	}
-	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineTwo); err != nil {
+	if err := rb.isAlphanumeric(opts, rb.RemittanceData.AddressLineTwo); err != nil {
 		return fieldError("AddressLineTwo", err, rb.RemittanceData.AddressLineTwo)
 	}
-	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineThree); err != nil {
+	if err := rb.isAlphanumeric(opts, rb.RemittanceData.AddressLineThree); err != nil {
 		return fieldError("AddressLineThree", err, rb.RemittanceData.AddressLineThree)
 	}
-	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineFour); err != nil {
+	if err := rb.isAlphanumeric(opts, rb.RemittanceData.AddressLineFour); err != nil {
 		return fieldError("AddressLineFour", err, rb.RemittanceData.AddressLineFour)
 	}
-	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineFive); err != nil {
+	if err := rb.isAlphanumeric(opts, rb.RemittanceData.AddressLineFive); err != nil {
 		return fieldError("AddressLineFive", err, rb.RemittanceData.AddressLineFive)
 	}
-	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineSix); err != nil {
+	if err := rb.isAlphanumeric(opts, rb.RemittanceData.AddressLineSix); err != nil {
 		return fieldError("AddressLineSix", err, rb.RemittanceData.AddressLineSix)
 	}
-	if err := rb.isAlphanumeric(rb.RemittanceData.AddressLineSeven); err != nil {
+	if err := rb.isAlphanumeric(opts, rb.RemittanceData.AddressLineSeven); err != nil {
 		return fieldError("AddressLineSeven", err, rb.RemittanceData.AddressLineSeven)
 	}
-	if err := rb.isAlphanumeric(rb.RemittanceData.CountryOfResidence); err != nil {
+	if err := rb.isAlphanumeric(opts, rb.RemittanceData.CountryOfResidence); err != nil {
 		return fieldError("CountryOfResidence", err, rb.RemittanceData.CountryOfResidence)
 	}
+	if rb.RemittanceData.CountryOfResidence != "" {
+		if err := rb.isCountryCode(opts, rb.RemittanceData.CountryOfResidence); err != nil {
+			return fieldError("CountryOfResidence", err, rb.RemittanceData.CountryOfResidence)
+		}
+	}
 
 	return nil
 }
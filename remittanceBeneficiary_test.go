@@ -155,6 +155,38 @@ func TestRemittanceBeneficiaryBuildingNumberAlphaNumeric(t *testing.T) {
 	require.EqualError(t, err, fieldError("BuildingNumber", ErrNonAlphanumeric, rb.RemittanceData.BuildingNumber).Error())
 }
 
+// TestRemittanceBeneficiaryCountryCode validates RemittanceBeneficiary Country and CountryOfResidence are country codes
+func TestRemittanceBeneficiaryCountryCode(t *testing.T) {
+	rb := mockRemittanceBeneficiary()
+	rb.RemittanceData.Country = "XX"
+
+	err := rb.Validate()
+
+	require.EqualError(t, err, fieldError("Country", ErrNonCountryCode, rb.RemittanceData.Country).Error())
+
+	rb = mockRemittanceBeneficiary()
+	rb.RemittanceData.CountryOfResidence = "XX"
+
+	err = rb.Validate()
+
+	require.EqualError(t, err, fieldError("CountryOfResidence", ErrNonCountryCode, rb.RemittanceData.CountryOfResidence).Error())
+}
+
+// TestRemittanceBeneficiaryCountryCodeShiftedFields validates a record missing the delimiter after BuildingNumber,
+// which shifts the following fields so Country holds the start of an address line
+func TestRemittanceBeneficiaryCountryCodeShiftedFields(t *testing.T) {
+	record := "{8350}Name*OI*CUST*111111*Bank**ADDR*Department*Sub-Department*Street Name*1619405*AnyTown*PA*UA*Address Line One*Address Line Two*Address Line Three*Address Line Four*Address Line Five*Address Line Six*Address Line Seven*US*"
+	rb := NewRemittanceBeneficiary()
+	require.NoError(t, rb.Parse(record))
+	require.Equal(t, "1619405", rb.RemittanceData.BuildingNumber)
+	require.Equal(t, "Ad", rb.RemittanceData.Country)
+
+	err := rb.Validate()
+
+	require.ErrorIs(t, err, ErrNonCountryCode)
+	require.EqualError(t, err, "Country Ad is not a recognized country code")
+}
+
 // TestRemittanceBeneficiaryPostCodeAlphaNumeric validates RemittanceBeneficiary PostCode is alphanumeric
 func TestRemittanceBeneficiaryPostCodeAlphaNumeric(t *testing.T) {
 	rb := mockRemittanceBeneficiary()
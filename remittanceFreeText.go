@@ -116,16 +116,21 @@ func (rft *RemittanceFreeText) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on RemittanceFreeText and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (rft *RemittanceFreeText) Validate() error {
+	return rft.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on RemittanceFreeText following opts, which may be nil
+func (rft *RemittanceFreeText) validate(opts *ValidateOpts) error {
 	if rft.tag != TagRemittanceFreeText {
 		return fieldError("tag", ErrValidTagForType, rft.tag)
 	}
-	if err := rft.isAlphanumeric(rft.LineOne); err != nil {
+	if err := rft.isAlphanumeric(opts, rft.LineOne); err != nil {
 		return fieldError("LineOne", err, rft.LineOne)
 	}
-	if err := rft.isAlphanumeric(rft.LineTwo); err != nil {
+	if err := rft.isAlphanumeric(opts, rft.LineTwo); err != nil {
 		return fieldError("LineTwo", err, rft.LineTwo)
 	}
-	if err := rft.isAlphanumeric(rft.LineThree); err != nil {
+	if err := rft.isAlphanumeric(opts, rft.LineThree); err != nil {
 		return fieldError("LineThree", err, rft.LineThree)
 	}
 	return nil
@@ -344,7 +344,12 @@ func (ro *RemittanceOriginator) Format(options FormatOptions) string {
 // * Identification Number is not permitted for Identification Code PICDateBirthPlace.
 // * Identification Number Issuer is not permitted for Identification Code OICSWIFTBICORBEI and PICDateBirthPlace.
 // * Date & Place of Birth is only permitted for Identification Code PICDateBirthPlace.
-func (ro *RemittanceOriginator) Validate() error { //nolint:gocyclo
+func (ro *RemittanceOriginator) Validate() error {
+	return ro.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on RemittanceOriginator following opts, which may be nil
+func (ro *RemittanceOriginator) validate(opts *ValidateOpts) error { //nolint:gocyclo
 	if err := ro.fieldInclusion(); err != nil {
 		return err
 	}
@@ -367,83 +372,93 @@ func (ro *RemittanceOriginator) Validate() error { //nolint:gocyclo
 		}
 	}
 
-	if err := ro.isAlphanumeric(ro.IdentificationNumber); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.IdentificationNumber); err != nil {
 		return fieldError("IdentificationNumber", err, ro.IdentificationNumber)
 	}
-	if err := ro.isAlphanumeric(ro.IdentificationNumberIssuer); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.IdentificationNumberIssuer); err != nil {
 		return fieldError("IdentificationNumberIssuer", err, ro.IdentificationNumberIssuer)
 	}
-	if err := ro.isAlphanumeric(ro.RemittanceData.Name); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.RemittanceData.Name); err != nil {
 		return fieldError("Name", err, ro.RemittanceData.Name)
 	}
 	if err := ro.isAddressType(ro.RemittanceData.AddressType); err != nil {
 		return fieldError("AddressType", err, ro.RemittanceData.AddressType)
 	}
-	if err := ro.isAlphanumeric(ro.RemittanceData.Department); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.RemittanceData.Department); err != nil {
 		return fieldError("Department", err, ro.RemittanceData.Department)
 	}
-	if err := ro.isAlphanumeric(ro.RemittanceData.SubDepartment); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.RemittanceData.SubDepartment); err != nil {
 		return fieldError("SubDepartment", err, ro.RemittanceData.SubDepartment)
 	}
-	if err := ro.isAlphanumeric(ro.RemittanceData.StreetName); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.RemittanceData.StreetName); err != nil {
 		return fieldError("StreetName", err, ro.RemittanceData.StreetName)
 	}
-	if err := ro.isAlphanumeric(ro.RemittanceData.BuildingNumber); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.RemittanceData.BuildingNumber); err != nil {
 		return fieldError("BuildingNumber", err, ro.RemittanceData.BuildingNumber)
 	}
-	if err := ro.isAlphanumeric(ro.RemittanceData.PostCode); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.RemittanceData.PostCode); err != nil {
 		return fieldError("PostCode", err, ro.RemittanceData.PostCode)
 	}
-	if err := ro.isAlphanumeric(ro.RemittanceData.TownName); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.RemittanceData.TownName); err != nil {
 		return fieldError("TownName", err, ro.RemittanceData.TownName)
 	}
-	if err := ro.isAlphanumeric(ro.RemittanceData.CountrySubDivisionState); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.RemittanceData.CountrySubDivisionState); err != nil {
 		return fieldError("CountrySubDivisionState", err, ro.RemittanceData.CountrySubDivisionState)
 	}
-	if err := ro.isAlphanumeric(ro.RemittanceData.Country); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.RemittanceData.Country); err != nil {
 		return fieldError("Country", err, ro.RemittanceData.Country)
 	}
-	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineOne); err != nil {
+	if ro.RemittanceData.Country != "" {
+		if err := ro.isCountryCode(opts, ro.RemittanceData.Country); err != nil {
+			return fieldError("Country", err, ro.RemittanceData.Country)
+		}
+	}
+	if err := ro.isAlphanumeric(opts, ro.RemittanceData.AddressLineOne); err != nil {
 		return fieldError("AddressLineOne", err, ro.RemittanceData.AddressLineOne)
 	}
-	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineTwo); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.RemittanceData.AddressLineTwo); err != nil {
 		return fieldError("AddressLineTwo", err, ro.RemittanceData.AddressLineTwo)
 	}
-	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineThree); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.RemittanceData.AddressLineThree); err != nil {
 		return fieldError("AddressLineThree", err, ro.RemittanceData.AddressLineThree)
 	}
-	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineFour); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.RemittanceData.AddressLineFour); err != nil {
 		return fieldError("AddressLineFour", err, ro.RemittanceData.AddressLineFour)
 	}
-	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineFive); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.RemittanceData.AddressLineFive); err != nil {
 		return fieldError("AddressLineFive", err, ro.RemittanceData.AddressLineFive)
 	}
-	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineSix); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.RemittanceData.AddressLineSix); err != nil {
 		return fieldError("AddressLineSix", err, ro.RemittanceData.AddressLineSix)
 	}
-	if err := ro.isAlphanumeric(ro.RemittanceData.AddressLineSeven); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.RemittanceData.AddressLineSeven); err != nil {
 		return fieldError("AddressLineSeven", err, ro.RemittanceData.AddressLineSeven)
 	}
 
-	if err := ro.isAlphanumeric(ro.RemittanceData.CountryOfResidence); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.RemittanceData.CountryOfResidence); err != nil {
 		return fieldError("CountryOfResidence", err, ro.RemittanceData.CountryOfResidence)
 	}
-	if err := ro.isAlphanumeric(ro.ContactName); err != nil {
+	if ro.RemittanceData.CountryOfResidence != "" {
+		if err := ro.isCountryCode(opts, ro.RemittanceData.CountryOfResidence); err != nil {
+			return fieldError("CountryOfResidence", err, ro.RemittanceData.CountryOfResidence)
+		}
+	}
+	if err := ro.isAlphanumeric(opts, ro.ContactName); err != nil {
 		return fieldError("ContactName", err, ro.ContactName)
 	}
-	if err := ro.isAlphanumeric(ro.ContactPhoneNumber); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.ContactPhoneNumber); err != nil {
 		return fieldError("ContactPhoneNumber", err, ro.ContactPhoneNumber)
 	}
-	if err := ro.isAlphanumeric(ro.ContactMobileNumber); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.ContactMobileNumber); err != nil {
 		return fieldError("ContactMobileNumber", err, ro.ContactMobileNumber)
 	}
-	if err := ro.isAlphanumeric(ro.ContactFaxNumber); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.ContactFaxNumber); err != nil {
 		return fieldError("ContactFaxNumber", err, ro.ContactFaxNumber)
 	}
-	if err := ro.isAlphanumeric(ro.ContactElectronicAddress); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.ContactElectronicAddress); err != nil {
 		return fieldError("ContactElectronicAddress", err, ro.ContactElectronicAddress)
 	}
-	if err := ro.isAlphanumeric(ro.ContactOther); err != nil {
+	if err := ro.isAlphanumeric(opts, ro.ContactOther); err != nil {
 		return fieldError("ContactOther", err, ro.ContactOther)
 	}
 	return nil
@@ -0,0 +1,255 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"reflect"
+	"strings"
+)
+
+// reversibleTypeSubTypes holds the TypeSubType associations of each business function code which may be reversed
+var reversibleTypeSubTypes = map[string]associatedTypeSubTypes{
+	BankTransfer:           btrTypeSubTypes,
+	CustomerTransfer:       ctrTypeSubTypes,
+	CustomerTransferPlus:   ctpTypeSubTypes,
+	CheckSameDaySettlement: cksTypeSubTypes,
+	DepositSendersAccount:  depTypeSubTypes,
+	FEDFundsReturned:       ffrTypeSubTypes,
+	FEDFundsSold:           ffsTypeSubTypes,
+}
+
+// NewReversalRequest returns a non-value request for the receiver of orig to reverse it. The {1510} SubTypeCode is
+// RequestReversal, or RequestReversalPriorDayTransfer when orig was sent on a prior business day, and {3500}
+// PreviousMessageIdentifier is the IMAD of orig.
+//
+// The request is sent by the sender of orig, so the parties are not changed. A CustomerTransferPlus keeps its
+// business function code and every tag of orig. Other transfers are requested with a BFCServiceMessage holding
+// the amount, references and parties of orig, as the tags prohibited by BFCServiceMessage are dropped.
+//
+// The {1520} InputMessageAccountabilityData is not set, and must be added before the request is sent,
+// such as from an IMADGenerator.
+func NewReversalRequest(orig *FEDWireMessage, priorDay bool) (*FEDWireMessage, error) {
+	subTypeCode, reversalSubTypeCode := RequestReversal, ReversalTransfer
+	if priorDay {
+		subTypeCode, reversalSubTypeCode = RequestReversalPriorDayTransfer, ReversalPriorDayTransfer
+	}
+	pmi, err := reversalPreviousMessageIdentifier(orig, reversalSubTypeCode)
+	if err != nil {
+		return nil, err
+	}
+
+	fwm := orig.clone()
+	fwm.ID = ""
+	fwm.MessageDisposition = nil
+	fwm.ReceiptTimeStamp = nil
+	fwm.OutputMessageAccountabilityData = nil
+	fwm.ErrorWire = nil
+	fwm.SenderSupplied = responseSenderSupplied(orig)
+	fwm.TypeSubType.SubTypeCode = subTypeCode
+	fwm.InputMessageAccountabilityData = nil
+	fwm.PreviousMessageIdentifier = pmi
+	fwm.UnknownTags = nil
+	fwm.Spans = nil
+	fwm.SourceFormat = nil
+	if orig.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus {
+		return fwm, nil
+	}
+
+	bfc := NewBusinessFunctionCode()
+	bfc.BusinessFunctionCode = BFCServiceMessage
+	return &FEDWireMessage{
+		SenderSupplied:                fwm.SenderSupplied,
+		TypeSubType:                   fwm.TypeSubType,
+		Amount:                        fwm.Amount,
+		SenderDepositoryInstitution:   fwm.SenderDepositoryInstitution,
+		ReceiverDepositoryInstitution: fwm.ReceiverDepositoryInstitution,
+		BusinessFunctionCode:          bfc,
+		SenderReference:               fwm.SenderReference,
+		PreviousMessageIdentifier:     fwm.PreviousMessageIdentifier,
+		BeneficiaryIntermediaryFI:     fwm.BeneficiaryIntermediaryFI,
+		BeneficiaryFI:                 fwm.BeneficiaryFI,
+		Beneficiary:                   fwm.Beneficiary,
+		BeneficiaryReference:          fwm.BeneficiaryReference,
+		Originator:                    fwm.Originator,
+		OriginatorFI:                  fwm.OriginatorFI,
+		InstructingFI:                 fwm.InstructingFI,
+		OriginatorToBeneficiary:       fwm.OriginatorToBeneficiary,
+		ValidateOptions:               fwm.ValidateOptions,
+	}, nil
+}
+
+// NewReversal returns a value reversal returning the funds of orig to its sender, which may be sent in response
+// to a request from NewReversalRequest. The {1510} SubTypeCode is ReversalTransfer, or ReversalPriorDayTransfer
+// when orig was received on a prior business day, and {3500} PreviousMessageIdentifier is the IMAD of orig.
+//
+// The reversal is sent by the receiver of orig, so the parties are swapped: the sender and receiver DIs,
+// Beneficiary and Originator, BeneficiaryFI and OriginatorFI, and BeneficiaryIntermediaryFI and InstructingFI.
+// An OriginatorOptionF of orig becomes the Beneficiary. The Amount, InstructedAmount, ExchangeRate and
+// BeneficiaryReference of orig are kept, while its other tags, such as Charges, cover payment and remittance
+// tags, are not.
+//
+// The {1520} InputMessageAccountabilityData is not set, and must be added before the reversal is sent,
+// such as from an IMADGenerator.
+func NewReversal(orig *FEDWireMessage, priorDay bool) (*FEDWireMessage, error) {
+	subTypeCode := ReversalTransfer
+	if priorDay {
+		subTypeCode = ReversalPriorDayTransfer
+	}
+	pmi, err := reversalPreviousMessageIdentifier(orig, subTypeCode)
+	if err != nil {
+		return nil, err
+	}
+
+	c := orig.clone()
+	c.TypeSubType.SubTypeCode = subTypeCode
+	fwm := &FEDWireMessage{
+		SenderSupplied:            responseSenderSupplied(orig),
+		TypeSubType:               c.TypeSubType,
+		Amount:                    c.Amount,
+		BusinessFunctionCode:      c.BusinessFunctionCode,
+		PreviousMessageIdentifier: pmi,
+		InstructedAmount:          c.InstructedAmount,
+		ExchangeRate:              c.ExchangeRate,
+		BeneficiaryReference:      c.BeneficiaryReference,
+		ValidateOptions:           c.ValidateOptions,
+	}
+
+	fwm.SenderDepositoryInstitution, fwm.ReceiverDepositoryInstitution = swapDepositoryInstitutions(orig)
+	switch {
+	case c.Originator != nil:
+		fwm.Beneficiary = NewBeneficiary()
+		fwm.Beneficiary.Personal = c.Originator.Personal
+	case c.OriginatorOptionF != nil:
+		fwm.Beneficiary = NewBeneficiary()
+		fwm.Beneficiary.Personal = optionFPersonal(c.OriginatorOptionF)
+	}
+	if c.Beneficiary != nil {
+		fwm.Originator = NewOriginator()
+		fwm.Originator.Personal = c.Beneficiary.Personal
+	}
+	if c.OriginatorFI != nil {
+		fwm.BeneficiaryFI = NewBeneficiaryFI()
+		fwm.BeneficiaryFI.FinancialInstitution = c.OriginatorFI.FinancialInstitution
+	}
+	if c.BeneficiaryFI != nil {
+		fwm.OriginatorFI = NewOriginatorFI()
+		fwm.OriginatorFI.FinancialInstitution = c.BeneficiaryFI.FinancialInstitution
+	}
+	if c.InstructingFI != nil {
+		fwm.BeneficiaryIntermediaryFI = NewBeneficiaryIntermediaryFI()
+		fwm.BeneficiaryIntermediaryFI.FinancialInstitution = c.InstructingFI.FinancialInstitution
+	}
+	if c.BeneficiaryIntermediaryFI != nil {
+		fwm.InstructingFI = NewInstructingFI()
+		fwm.InstructingFI.FinancialInstitution = c.BeneficiaryIntermediaryFI.FinancialInstitution
+	}
+	return fwm, nil
+}
+
+// reversalPreviousMessageIdentifier returns the {3500} PreviousMessageIdentifier of a reversal of orig, which is
+// the IMAD of orig. An error is returned when the business function code of orig can't be reversed with the value
+// reversal subTypeCode.
+func reversalPreviousMessageIdentifier(orig *FEDWireMessage, subTypeCode string) (*PreviousMessageIdentifier, error) {
+	switch {
+	case orig.TypeSubType == nil:
+		return nil, fieldError("TypeSubType", ErrFieldRequired)
+	case orig.InputMessageAccountabilityData == nil:
+		return nil, fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	case orig.BusinessFunctionCode == nil:
+		return nil, fieldError("BusinessFunctionCode", ErrFieldRequired)
+	}
+
+	bfc := orig.BusinessFunctionCode.BusinessFunctionCode
+	typeSubType := orig.TypeSubType.TypeCode + subTypeCode
+	if !reversibleTypeSubTypes[bfc].Contains(typeSubType) {
+		return nil, fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType, bfc))
+	}
+
+	return newPreviousMessageIdentifier(orig.InputMessageAccountabilityData), nil
+}
+
+// newPreviousMessageIdentifier returns a {3500} PreviousMessageIdentifier referring to the message with imad
+func newPreviousMessageIdentifier(imad *InputMessageAccountabilityData) *PreviousMessageIdentifier {
+	pmi := NewPreviousMessageIdentifier()
+	pmi.PreviousMessageIdentifier = imad.InputCycleDate + imad.InputSource + imad.InputSequenceNumber
+	return pmi
+}
+
+// responseSenderSupplied returns the {1500} SenderSupplied of a message sent in response to orig, which is
+// sent to the same environment as orig
+func responseSenderSupplied(orig *FEDWireMessage) *SenderSupplied {
+	ss := NewSenderSupplied()
+	if orig.SenderSupplied != nil {
+		ss.TestProductionCode = orig.SenderSupplied.TestProductionCode
+	}
+	return ss
+}
+
+// swapDepositoryInstitutions returns the {3100} SenderDepositoryInstitution and {3400} ReceiverDepositoryInstitution
+// of a message sent back to the sender of orig
+func swapDepositoryInstitutions(orig *FEDWireMessage) (*SenderDepositoryInstitution, *ReceiverDepositoryInstitution) {
+	var sdi *SenderDepositoryInstitution
+	if orig.ReceiverDepositoryInstitution != nil {
+		sdi = NewSenderDepositoryInstitution()
+		sdi.SenderABANumber = orig.ReceiverDepositoryInstitution.ReceiverABANumber
+		sdi.SenderShortName = orig.ReceiverDepositoryInstitution.ReceiverShortName
+	}
+	var rdi *ReceiverDepositoryInstitution
+	if orig.SenderDepositoryInstitution != nil {
+		rdi = NewReceiverDepositoryInstitution()
+		rdi.ReceiverABANumber = orig.SenderDepositoryInstitution.SenderABANumber
+		rdi.ReceiverShortName = orig.SenderDepositoryInstitution.SenderShortName
+	}
+	return sdi, rdi
+}
+
+// optionFIdentificationCodes maps the unique identifier codes of an OriginatorOptionF PartyIdentifier to
+// the IdentificationCode of a Personal
+var optionFIdentificationCodes = map[string]string{
+	"ARNU": AlienRegistrationNumber,
+	"CCPT": PassportNumber,
+	"DRLC": DriversLicenseNumber,
+	"TXID": TaxIdentificationNumber,
+}
+
+// optionFPersonal returns the Personal details of the party in oof. An account number or unique identifier
+// without an IdentificationCode of its own is returned as an OtherIdentification, and the address is taken
+// from lines with line code 2 or 3.
+func optionFPersonal(oof *OriginatorOptionF) Personal {
+	var p Personal
+	if account, ok := strings.CutPrefix(oof.PartyIdentifier, "/"); ok {
+		p.IdentificationCode, p.Identifier = DemandDepositAccountNumber, account
+	} else if code, identifier, ok := strings.Cut(oof.PartyIdentifier, "/"); ok {
+		p.IdentificationCode, p.Identifier = OtherIdentification, identifier
+		if idCode, ok := optionFIdentificationCodes[code]; ok {
+			p.IdentificationCode = idCode
+		}
+	}
+	p.Name = strings.TrimPrefix(oof.Name, "1/")
+
+	var address []string
+	for _, line := range []string{oof.LineOne, oof.LineTwo, oof.LineThree} {
+		if strings.HasPrefix(line, "2/") || strings.HasPrefix(line, "3/") {
+			address = append(address, line[2:])
+		}
+	}
+	address = append(address, make([]string, 3-len(address))...)
+	p.Address.AddressLineOne, p.Address.AddressLineTwo, p.Address.AddressLineThree = address[0], address[1], address[2]
+	return p
+}
+
+// clone returns a copy of fwm holding a copy of each tag, so the copy can be changed without changing fwm
+func (fwm *FEDWireMessage) clone() *FEDWireMessage {
+	c := *fwm
+	v := reflect.ValueOf(&c).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if field := v.Field(i); field.Kind() == reflect.Pointer && !field.IsNil() && field.CanSet() {
+			copied := reflect.New(field.Elem().Type())
+			copied.Elem().Set(field.Elem())
+			field.Set(copied)
+		}
+	}
+	return &c
+}
@@ -0,0 +1,133 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockReversibleTransfer creates a CustomerTransfer to be reversed
+func mockReversibleTransfer() *FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.SenderSupplied.TestProductionCode = EnvironmentTest
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	fwm.OriginatorFI = mockOriginatorFI()
+	fwm.Charges = mockCharges()
+	return &fwm
+}
+
+func TestNewReversalRequest(t *testing.T) {
+	orig := mockReversibleTransfer()
+	imad := orig.InputMessageAccountabilityData
+
+	fwm, err := NewReversalRequest(orig, false)
+	require.NoError(t, err)
+	require.Equal(t, BFCServiceMessage, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, RequestReversal, fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, imad.InputCycleDate+imad.InputSource+imad.InputSequenceNumber, fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, EnvironmentTest, fwm.SenderSupplied.TestProductionCode)
+	require.Nil(t, fwm.InputMessageAccountabilityData)
+	require.Nil(t, fwm.Charges)
+	require.Equal(t, orig.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, orig.Originator.Personal, fwm.Originator.Personal)
+
+	// orig is not changed
+	require.Equal(t, BasicFundsTransfer, orig.TypeSubType.SubTypeCode)
+	require.Equal(t, CustomerTransfer, orig.BusinessFunctionCode.BusinessFunctionCode)
+
+	fwm.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	require.NoError(t, fwm.ValidateAll())
+
+	fwm, err = NewReversalRequest(orig, true)
+	require.NoError(t, err)
+	require.Equal(t, RequestReversalPriorDayTransfer, fwm.TypeSubType.SubTypeCode)
+}
+
+func TestNewReversalRequest_customerTransferPlus(t *testing.T) {
+	orig := mockReversibleTransfer()
+	orig.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	orig.BusinessFunctionCode.TransactionTypeCode = ""
+	orig.LocalInstrument = mockLocalInstrument()
+	orig.LocalInstrument.LocalInstrumentCode = NarrativeText
+	orig.UnstructuredAddenda = mockUnstructuredAddenda()
+
+	fwm, err := NewReversalRequest(orig, false)
+	require.NoError(t, err)
+	require.Equal(t, CustomerTransferPlus, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, orig.UnstructuredAddenda, fwm.UnstructuredAddenda)
+	require.NotSame(t, orig.UnstructuredAddenda, fwm.UnstructuredAddenda)
+
+	fwm.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	require.NoError(t, fwm.ValidateAll())
+}
+
+func TestNewReversal(t *testing.T) {
+	orig := mockReversibleTransfer()
+	orig.BeneficiaryFI = mockBeneficiaryFI()
+
+	fwm, err := NewReversal(orig, true)
+	require.NoError(t, err)
+	require.Equal(t, CustomerTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, ReversalPriorDayTransfer, fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, orig.Amount.Amount, fwm.Amount.Amount)
+	require.Equal(t, orig.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, orig.SenderDepositoryInstitution.SenderABANumber, fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+	require.Equal(t, orig.Originator.Personal, fwm.Beneficiary.Personal)
+	require.Equal(t, orig.Beneficiary.Personal, fwm.Originator.Personal)
+	require.Equal(t, orig.OriginatorFI.FinancialInstitution, fwm.BeneficiaryFI.FinancialInstitution)
+	require.Equal(t, orig.BeneficiaryFI.FinancialInstitution, fwm.OriginatorFI.FinancialInstitution)
+	require.Nil(t, fwm.Charges)
+
+	// the reversal must pass checkPreviousMessageIdentifier
+	fwm.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	require.NoError(t, fwm.checkPreviousMessageIdentifier())
+	require.NoError(t, fwm.ValidateAll())
+
+	fwm, err = NewReversal(orig, false)
+	require.NoError(t, err)
+	require.Equal(t, ReversalTransfer, fwm.TypeSubType.SubTypeCode)
+}
+
+func TestNewReversal_originatorOptionF(t *testing.T) {
+	orig := mockReversibleTransfer()
+	orig.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	orig.BusinessFunctionCode.TransactionTypeCode = ""
+	orig.Originator = nil
+	orig.OriginatorOptionF = mockOriginatorOptionF()
+
+	fwm, err := NewReversal(orig, false)
+	require.NoError(t, err)
+	require.Nil(t, fwm.OriginatorOptionF)
+	require.Equal(t, Personal{
+		IdentificationCode: TaxIdentificationNumber,
+		Identifier:         "123-45-6789",
+		Name:               "Name",
+		Address: Address{
+			AddressLineOne: "1000 Colonial Farm Rd",
+		},
+	}, fwm.Beneficiary.Personal)
+
+	fwm.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	require.NoError(t, fwm.ValidateAll())
+}
+
+func TestNewReversal_invalid(t *testing.T) {
+	orig := mockReversibleTransfer()
+	orig.InputMessageAccountabilityData = nil
+	_, err := NewReversal(orig, false)
+	require.ErrorIs(t, err, ErrFieldRequired)
+
+	orig = mockReversibleTransfer()
+	orig.BusinessFunctionCode.BusinessFunctionCode = DrawdownResponse
+	_, err = NewReversal(orig, false)
+	require.EqualError(t, err, fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType",
+		FundsTransfer+ReversalTransfer, DrawdownResponse)).Error())
+
+	_, err = NewReversalRequest(orig, true)
+	require.Error(t, err)
+}
@@ -128,6 +128,11 @@ func (srd *SecondaryRemittanceDocument) Format(options FormatOptions) string {
 // * Document Type Code and Document Identification Number are mandatory.
 // * Proprietary Document Type Code is mandatory for Document Type Code PROP; otherwise not permitted.
 func (srd *SecondaryRemittanceDocument) Validate() error {
+	return srd.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on SecondaryRemittanceDocument following opts, which may be nil
+func (srd *SecondaryRemittanceDocument) validate(opts *ValidateOpts) error {
 	if err := srd.fieldInclusion(); err != nil {
 		return err
 	}
@@ -137,13 +142,13 @@ func (srd *SecondaryRemittanceDocument) Validate() error {
 	if err := srd.isDocumentTypeCode(srd.DocumentTypeCode); err != nil {
 		return fieldError("DocumentTypeCode", err, srd.DocumentTypeCode)
 	}
-	if err := srd.isAlphanumeric(srd.ProprietaryDocumentTypeCode); err != nil {
+	if err := srd.isAlphanumeric(opts, srd.ProprietaryDocumentTypeCode); err != nil {
 		return fieldError("ProprietaryDocumentTypeCode", err, srd.ProprietaryDocumentTypeCode)
 	}
-	if err := srd.isAlphanumeric(srd.DocumentIdentificationNumber); err != nil {
+	if err := srd.isAlphanumeric(opts, srd.DocumentIdentificationNumber); err != nil {
 		return fieldError("DocumentIdentificationNumber", err, srd.DocumentIdentificationNumber)
 	}
-	if err := srd.isAlphanumeric(srd.Issuer); err != nil {
+	if err := srd.isAlphanumeric(opts, srd.Issuer); err != nil {
 		return fieldError("Issuer", err, srd.Issuer)
 	}
 	return nil
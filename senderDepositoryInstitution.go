@@ -102,6 +102,11 @@ func (sdi *SenderDepositoryInstitution) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on SenderDepositoryInstitution and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (sdi *SenderDepositoryInstitution) Validate() error {
+	return sdi.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on SenderDepositoryInstitution following opts, which may be nil
+func (sdi *SenderDepositoryInstitution) validate(opts *ValidateOpts) error {
 	if err := sdi.fieldInclusion(); err != nil {
 		return err
 	}
@@ -111,7 +116,10 @@ func (sdi *SenderDepositoryInstitution) Validate() error {
 	if err := sdi.isNumeric(sdi.SenderABANumber); err != nil {
 		return fieldError("SenderABANumber", err, sdi.SenderABANumber)
 	}
-	if err := sdi.isAlphanumeric(sdi.SenderShortName); err != nil {
+	if err := sdi.isABANumber(opts, sdi.SenderABANumber); err != nil {
+		return fieldError("SenderABANumber", err, sdi.SenderABANumber)
+	}
+	if err := sdi.isAlphanumeric(opts, sdi.SenderShortName); err != nil {
 		return fieldError("SenderShortName", err, sdi.SenderShortName)
 	}
 	return nil
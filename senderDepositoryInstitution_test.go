@@ -55,6 +55,18 @@ func TestSenderABANumberRequired(t *testing.T) {
 	require.EqualError(t, err, fieldError("SenderABANumber", ErrFieldRequired, rdi.SenderABANumber).Error())
 }
 
+// TestSenderABANumberCheckDigit validates the check digit of SenderDepositoryInstitution SenderABANumber
+func TestSenderABANumberCheckDigit(t *testing.T) {
+	sdi := mockSenderDepositoryInstitution()
+	sdi.SenderABANumber = "121042883"
+
+	err := sdi.Validate()
+
+	require.EqualError(t, err, fieldError("SenderABANumber", ErrABANumber, sdi.SenderABANumber).Error())
+
+	require.NoError(t, sdi.validate(&ValidateOpts{SkipRules: []string{RuleABACheckDigit}}))
+}
+
 // TestParseSenderWrongLength parses a wrong Sender record length
 func TestParseSenderWrongLength(t *testing.T) {
 	var line = "{3100}0012"
@@ -91,14 +103,14 @@ func TestSenderDepositoryInstitutionTagError(t *testing.T) {
 
 // TestStringSenderDepositoryInstitutionVariableLength parses using variable length
 func TestStringSenderDepositoryInstitutionVariableLength(t *testing.T) {
-	var line = "{3100}1        A*"
+	var line = "{3100}121042882A*"
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 
 	err := r.parseSenderDepositoryInstitution()
 	require.NoError(t, err)
 
-	line = "{3100}1        A                 NNN"
+	line = "{3100}121042882A                 NNN"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -112,7 +124,7 @@ func TestStringSenderDepositoryInstitutionVariableLength(t *testing.T) {
 	err = r.parseSenderDepositoryInstitution()
 	require.ErrorContains(t, err, ErrValidLength.Error())
 
-	line = "{3100}1        A*"
+	line = "{3100}121042882A*"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -122,7 +134,7 @@ func TestStringSenderDepositoryInstitutionVariableLength(t *testing.T) {
 
 // TestStringSenderDepositoryInstitutionOptions validates Format() formatted according to the FormatOptions
 func TestStringSenderDepositoryInstitutionOptions(t *testing.T) {
-	var line = "{3100}1        A*"
+	var line = "{3100}121042882A*"
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -130,11 +142,11 @@ func TestStringSenderDepositoryInstitutionOptions(t *testing.T) {
 	require.NoError(t, err)
 
 	record := r.currentFEDWireMessage.SenderDepositoryInstitution
-	require.Equal(t, "{3100}1        A                 *", record.String())
-	require.Equal(t, "{3100}1        A*", record.Format(FormatOptions{VariableLengthFields: true}))
+	require.Equal(t, "{3100}121042882A                 *", record.String())
+	require.Equal(t, "{3100}121042882A*", record.Format(FormatOptions{VariableLengthFields: true}))
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 
-	line = "{3100}1        *"
+	line = "{3100}121042882*"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -142,11 +154,11 @@ func TestStringSenderDepositoryInstitutionOptions(t *testing.T) {
 	require.NoError(t, err)
 
 	record = r.currentFEDWireMessage.SenderDepositoryInstitution
-	require.Equal(t, "{3100}1                          *", record.String())
-	require.Equal(t, "{3100}1        *", record.Format(FormatOptions{VariableLengthFields: true}))
+	require.Equal(t, "{3100}121042882                  *", record.String())
+	require.Equal(t, "{3100}121042882*", record.Format(FormatOptions{VariableLengthFields: true}))
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 
-	line = "{3100}111111111*"
+	line = "{3100}231380104*"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -154,7 +166,7 @@ func TestStringSenderDepositoryInstitutionOptions(t *testing.T) {
 	require.NoError(t, err)
 
 	record = r.currentFEDWireMessage.SenderDepositoryInstitution
-	require.Equal(t, "{3100}111111111                  *", record.String())
-	require.Equal(t, "{3100}111111111*", record.Format(FormatOptions{VariableLengthFields: true}))
+	require.Equal(t, "{3100}231380104                  *", record.String())
+	require.Equal(t, "{3100}231380104*", record.Format(FormatOptions{VariableLengthFields: true}))
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
@@ -92,10 +92,15 @@ func (sr *SenderReference) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on SenderReference and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (sr *SenderReference) Validate() error {
+	return sr.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on SenderReference following opts, which may be nil
+func (sr *SenderReference) validate(opts *ValidateOpts) error {
 	if sr.tag != TagSenderReference {
 		return fieldError("tag", ErrValidTagForType, sr.tag)
 	}
-	if err := sr.isAlphanumeric(sr.SenderReference); err != nil {
+	if err := sr.isAlphanumeric(opts, sr.SenderReference); err != nil {
 		return fieldError("SenderReference", err, sr.SenderReference)
 	}
 	return nil
@@ -115,6 +115,11 @@ func (ss *SenderSupplied) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on SenderSupplied and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (ss *SenderSupplied) Validate() error {
+	return ss.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on SenderSupplied following opts, which may be nil
+func (ss *SenderSupplied) validate(opts *ValidateOpts) error {
 	if err := ss.fieldInclusion(); err != nil {
 		return err
 	}
@@ -124,7 +129,7 @@ func (ss *SenderSupplied) Validate() error {
 	if ss.FormatVersion != FormatVersion {
 		return fieldError("FormatVersion", ErrFormatVersion, ss.FormatVersion)
 	}
-	if err := ss.isAlphanumeric(ss.UserRequestCorrelation); err != nil {
+	if err := ss.isAlphanumeric(opts, ss.UserRequestCorrelation); err != nil {
 		return fieldError("UserRequestCorrelation", err, ss.UserRequestCorrelation)
 	}
 	if err := ss.isTestProductionCode(ss.TestProductionCode); err != nil {
@@ -144,28 +144,33 @@ func (str *SenderToReceiver) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on SenderToReceiver and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (str *SenderToReceiver) Validate() error {
+	return str.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on SenderToReceiver following opts, which may be nil
+func (str *SenderToReceiver) validate(opts *ValidateOpts) error {
 	if str.tag != TagSenderToReceiver {
 		return fieldError("tag", ErrValidTagForType, str.tag)
 	}
-	if err := str.isAlphanumeric(str.CoverPayment.SwiftFieldTag); err != nil {
+	if err := str.isAlphanumeric(opts, str.CoverPayment.SwiftFieldTag); err != nil {
 		return fieldError("SwiftFieldTag", err, str.CoverPayment.SwiftFieldTag)
 	}
-	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineOne); err != nil {
+	if err := str.isAlphanumeric(opts, str.CoverPayment.SwiftLineOne); err != nil {
 		return fieldError("SwiftLineOne", err, str.CoverPayment.SwiftLineOne)
 	}
-	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineTwo); err != nil {
+	if err := str.isAlphanumeric(opts, str.CoverPayment.SwiftLineTwo); err != nil {
 		return fieldError("SwiftLineTwo", err, str.CoverPayment.SwiftLineTwo)
 	}
-	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineThree); err != nil {
+	if err := str.isAlphanumeric(opts, str.CoverPayment.SwiftLineThree); err != nil {
 		return fieldError("SwiftLineThree", err, str.CoverPayment.SwiftLineThree)
 	}
-	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineFour); err != nil {
+	if err := str.isAlphanumeric(opts, str.CoverPayment.SwiftLineFour); err != nil {
 		return fieldError("SwiftLineFour", err, str.CoverPayment.SwiftLineFour)
 	}
-	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineFive); err != nil {
+	if err := str.isAlphanumeric(opts, str.CoverPayment.SwiftLineFive); err != nil {
 		return fieldError("SwiftLineFive", err, str.CoverPayment.SwiftLineFive)
 	}
-	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineSix); err != nil {
+	if err := str.isAlphanumeric(opts, str.CoverPayment.SwiftLineSix); err != nil {
 		return fieldError("SwiftLineSix", err, str.CoverPayment.SwiftLineSix)
 	}
 	return nil
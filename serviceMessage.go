@@ -206,46 +206,51 @@ func (sm *ServiceMessage) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on ServiceMessage and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (sm *ServiceMessage) Validate() error {
+	return sm.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on ServiceMessage following opts, which may be nil
+func (sm *ServiceMessage) validate(opts *ValidateOpts) error {
 	if err := sm.fieldInclusion(); err != nil {
 		return err
 	}
 	if sm.tag != TagServiceMessage {
 		return fieldError("tag", ErrValidTagForType, sm.tag)
 	}
-	if err := sm.isAlphanumeric(sm.LineOne); err != nil {
+	if err := sm.isAlphanumeric(opts, sm.LineOne); err != nil {
 		return fieldError("LineOne", err, sm.LineOne)
 	}
-	if err := sm.isAlphanumeric(sm.LineTwo); err != nil {
+	if err := sm.isAlphanumeric(opts, sm.LineTwo); err != nil {
 		return fieldError("LineTwo", err, sm.LineTwo)
 	}
-	if err := sm.isAlphanumeric(sm.LineThree); err != nil {
+	if err := sm.isAlphanumeric(opts, sm.LineThree); err != nil {
 		return fieldError("LineThree", err, sm.LineThree)
 	}
-	if err := sm.isAlphanumeric(sm.LineFour); err != nil {
+	if err := sm.isAlphanumeric(opts, sm.LineFour); err != nil {
 		return fieldError("LineFour", err, sm.LineFour)
 	}
-	if err := sm.isAlphanumeric(sm.LineFive); err != nil {
+	if err := sm.isAlphanumeric(opts, sm.LineFive); err != nil {
 		return fieldError("LineFive", err, sm.LineFive)
 	}
-	if err := sm.isAlphanumeric(sm.LineSix); err != nil {
+	if err := sm.isAlphanumeric(opts, sm.LineSix); err != nil {
 		return fieldError("LineSix", err, sm.LineSix)
 	}
-	if err := sm.isAlphanumeric(sm.LineSeven); err != nil {
+	if err := sm.isAlphanumeric(opts, sm.LineSeven); err != nil {
 		return fieldError("LineSeven", err, sm.LineSeven)
 	}
-	if err := sm.isAlphanumeric(sm.LineEight); err != nil {
+	if err := sm.isAlphanumeric(opts, sm.LineEight); err != nil {
 		return fieldError("LineEight", err, sm.LineEight)
 	}
-	if err := sm.isAlphanumeric(sm.LineNine); err != nil {
+	if err := sm.isAlphanumeric(opts, sm.LineNine); err != nil {
 		return fieldError("LineNine", err, sm.LineNine)
 	}
-	if err := sm.isAlphanumeric(sm.LineTen); err != nil {
+	if err := sm.isAlphanumeric(opts, sm.LineTen); err != nil {
 		return fieldError("LineTen", err, sm.LineTen)
 	}
-	if err := sm.isAlphanumeric(sm.LineEleven); err != nil {
+	if err := sm.isAlphanumeric(opts, sm.LineEleven); err != nil {
 		return fieldError("LineEleven", err, sm.LineEleven)
 	}
-	if err := sm.isAlphanumeric(sm.LineTwelve); err != nil {
+	if err := sm.isAlphanumeric(opts, sm.LineTwelve); err != nil {
 		return fieldError("LineTwelve", err, sm.LineTwelve)
 	}
 	return nil
@@ -0,0 +1,54 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import "strings"
+
+// SourceFormat records how each tag of a FEDWireMessage was formatted when it was read, in the
+// order they were read. It's kept when ReaderOpts.PreserveFormat is set.
+//
+// Writer uses SourceFormat to write tags which have not been modified exactly as they were read.
+// Modified tags are written in the format they were read in, and tags which were added are written
+// after them.
+type SourceFormat struct {
+	Tags []TagFormat
+}
+
+// TagFormat is how a tag was formatted when it was read
+type TagFormat struct {
+	// Tag is the tag number, such as {1500}
+	Tag string
+	// Raw is the tag exactly as it was read
+	Raw string
+	// Prefix holds input preceding the tag which wasn't part of any tag, such as header data
+	Prefix string
+	// Suffix holds input following the tag until the next one, such as newlines
+	Suffix string
+	// VariableLength is set when the tag was read without fixed-width fields
+	VariableLength bool
+	// TrailingDelimiter is set when the tag ended with a delimiter
+	TrailingDelimiter bool
+
+	// value is the tag as written by String() when it was read, used to find modified tags
+	value string
+}
+
+// format returns tag, which is the current value of the tag tf was read as, formatted like it was read.
+func (tf *TagFormat) format(tag interface{ String() string }) string {
+	value := tag.String()
+	if value == tf.value {
+		return tf.Raw
+	}
+	if !tf.VariableLength {
+		return value
+	}
+	if f, ok := tag.(interface{ Format(FormatOptions) string }); ok {
+		value = strings.TrimRight(f.Format(FormatOptions{VariableLengthFields: true}), Delimiter)
+		if tf.TrailingDelimiter {
+			value += Delimiter
+		}
+	}
+	return value
+}
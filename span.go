@@ -0,0 +1,16 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// Span is where a tag was found in the input given to Reader. Spans are only kept
+// when ReaderOpts.RecordSpans is set.
+type Span struct {
+	// Offset is the number of bytes preceding the tag in the input
+	Offset int64 `json:"offset"`
+	// Length is the number of bytes the tag covers, excluding any trailing newline
+	Length int `json:"length"`
+	// Raw is the tag exactly as it appeared in the input
+	Raw string `json:"raw"`
+}
@@ -0,0 +1,126 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"cmp"
+	"slices"
+)
+
+// tagGroups lists the tags of each group within a FEDWireMessage, in the order Writer writes them.
+// Tags must be in ascending order within their group.
+var tagGroups = [][]string{
+	// mandatory
+	{
+		TagSenderSupplied, TagTypeSubType, TagInputMessageAccountabilityData, TagAmount,
+		TagSenderDepositoryInstitution, TagReceiverDepositoryInstitution, TagBusinessFunctionCode,
+	},
+	// other transfer information
+	{
+		TagSenderReference, TagPreviousMessageIdentifier, TagLocalInstrument, TagPaymentNotification,
+		TagCharges, TagInstructedAmount, TagExchangeRate,
+	},
+	// beneficiary
+	{
+		TagBeneficiaryIntermediaryFI, TagBeneficiaryFI, TagBeneficiary, TagBeneficiaryReference,
+		TagAccountDebitedDrawdown,
+	},
+	// originator
+	{
+		TagOriginator, TagOriginatorOptionF, TagOriginatorFI, TagInstructingFI, TagAccountCreditedDrawdown,
+		TagOriginatorToBeneficiary,
+	},
+	// financial institution to financial institution
+	{
+		TagFIReceiverFI, TagFIDrawdownDebitAccountAdvice, TagFIIntermediaryFI, TagFIIntermediaryFIAdvice,
+		TagFIBeneficiaryFI, TagFIBeneficiaryFIAdvice, TagFIBeneficiary, TagFIBeneficiaryAdvice,
+		TagFIPaymentMethodToBeneficiary, TagFIAdditionalFIToFI,
+	},
+	// cover payment
+	{
+		TagCurrencyInstructedAmount, TagOrderingCustomer, TagOrderingInstitution, TagIntermediaryInstitution,
+		TagInstitutionAccount, TagBeneficiaryCustomer, TagRemittance, TagSenderToReceiver,
+	},
+	// unstructured addenda
+	{TagUnstructuredAddenda},
+	// related remittance and structured remittance
+	{
+		TagRelatedRemittance, TagRemittanceOriginator, TagRemittanceBeneficiary, TagPrimaryRemittanceDocument,
+		TagActualAmountPaid, TagGrossAmountRemittanceDocument, TagAmountNegotiatedDiscount, TagAdjustment,
+		TagDateRemittanceDocument, TagSecondaryRemittanceDocument, TagRemittanceFreeText,
+	},
+	// service message
+	{TagServiceMessage},
+	// appended by the Fedwire Funds Service
+	{TagMessageDisposition, TagReceiptTimeStamp, TagOutputMessageAccountabilityData, TagErrorWire},
+}
+
+// tagGroup maps each tag to its position in tagGroups
+var tagGroup = func() map[string]int {
+	groups := make(map[string]int)
+	for i := range tagGroups {
+		for _, tag := range tagGroups[i] {
+			groups[tag] = i
+		}
+	}
+	return groups
+}()
+
+// tagOrder holds the last tag found within each group of a FEDWireMessage
+type tagOrder map[int]string
+
+// check returns an ErrTagOrder when tag, found at position within its FEDWireMessage, follows a tag
+// of its group with a higher number. Unknown tags are not checked.
+func (o tagOrder) check(tag string, position int) error {
+	group, ok := tagGroup[tag]
+	if !ok {
+		return nil
+	}
+	previous, found := o[group]
+	o[group] = tag
+	if found && tag < previous {
+		return NewErrTagOrder(tag, previous, position)
+	}
+	return nil
+}
+
+// sourceTagOrder returns the tags of fwm in the order they were read, which is known when fwm was read
+// by Reader or holds Spans. It returns nil otherwise.
+func (fwm *FEDWireMessage) sourceTagOrder() []string {
+	var tags []string
+	switch {
+	case fwm.readTags != nil:
+		tags = fwm.readTags
+	case fwm.SourceFormat != nil:
+		for _, tf := range fwm.SourceFormat.Tags {
+			tags = append(tags, tf.Tag)
+		}
+	case len(fwm.Spans) > 0:
+		for tag := range fwm.Spans {
+			tags = append(tags, tag)
+		}
+		slices.SortFunc(tags, func(a, b string) int {
+			return cmp.Compare(fwm.Spans[a].Offset, fwm.Spans[b].Offset)
+		})
+	}
+	return tags
+}
+
+// validateTagOrder returns a FieldError for each tag which was read out of order, or ErrTagOrderUnknown
+// when the order fwm was read in isn't known
+func (fwm *FEDWireMessage) validateTagOrder() []error {
+	tags := fwm.sourceTagOrder()
+	if tags == nil {
+		return []error{ErrTagOrderUnknown}
+	}
+	var errs []error
+	order := make(tagOrder)
+	for i, tag := range tags {
+		if err := order.check(tag, i+1); err != nil {
+			errs = append(errs, fieldError(tagFields[tag], err))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,71 @@
+package wire
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagOrder_check(t *testing.T) {
+	order := make(tagOrder)
+	require.NoError(t, order.check(TagSenderSupplied, 1))
+	require.NoError(t, order.check(TagBusinessFunctionCode, 2))
+	// {3320} is in a different group from {3600}
+	require.NoError(t, order.check(TagSenderReference, 3))
+	require.Equal(t, NewErrTagOrder(TagAmount, TagBusinessFunctionCode, 4), order.check(TagAmount, 4))
+	// each tag is compared with the one read before it
+	require.NoError(t, order.check(TagSenderDepositoryInstitution, 5))
+	// unknown tags are not checked
+	require.NoError(t, order.check("{9990}", 6))
+	require.NoError(t, order.check("{0001}", 7))
+}
+
+func TestFEDWireMessage_validateTagOrder(t *testing.T) {
+	input := `{1500}30User ReqT 
+{1510}1000
+{1520}20190410Source08000001
+{2000}000001234567
+{3100}121042882Wells Fargo NA*
+{3600}CTR   *
+{3400}231380104Citadel*
+{4200}31234*Name*Address One*Address Two*Address Three*
+{5000}11234*Name*Address One**Address Three*
+`
+	for _, opts := range []ReaderOpts{{}, {PreserveFormat: true}, {RecordSpans: true}} {
+		r := NewReader(strings.NewReader(input))
+		r.SetOptions(&opts)
+		file, err := r.Read()
+		require.NoError(t, err)
+		fwm := file.FEDWireMessage
+
+		require.NoError(t, fwm.verify())
+		fwm.ValidateOptions = &ValidateOpts{CheckTagOrder: true}
+		require.EqualError(t, fwm.verify(), "ReceiverDepositoryInstitution {3400} at position 7 is out of order, it must precede {3600}")
+
+		err = fwm.ValidateAll()
+		var el base.ErrorList
+		require.ErrorAs(t, err, &el)
+		require.Len(t, el, 1)
+		fe := el[0].(*FieldError)
+		require.Equal(t, TagReceiverDepositoryInstitution, fe.Tag)
+		require.ErrorIs(t, fe, NewErrTagOrder(TagReceiverDepositoryInstitution, TagBusinessFunctionCode, 7))
+	}
+}
+
+func TestFEDWireMessage_validateTagOrderUnknown(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.json"))
+	require.NoError(t, err)
+	file, err := FileFromJSON(bs)
+	require.NoError(t, err)
+	fwm := file.FEDWireMessage
+	require.NoError(t, fwm.verify())
+
+	// the order of tags read from JSON isn't known
+	fwm.ValidateOptions = &ValidateOpts{CheckTagOrder: true}
+	require.ErrorIs(t, fwm.verify(), ErrTagOrderUnknown)
+	require.True(t, base.Has(fwm.ValidateAll(), ErrTagOrderUnknown))
+}
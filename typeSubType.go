@@ -74,6 +74,11 @@ func (tst *TypeSubType) String() string {
 // Validate performs WIRE format rule checks on TypeSubType and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (tst *TypeSubType) Validate() error {
+	return tst.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on TypeSubType following opts, which may be nil
+func (tst *TypeSubType) validate(opts *ValidateOpts) error {
 	if err := tst.fieldInclusion(); err != nil {
 		return err
 	}
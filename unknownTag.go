@@ -0,0 +1,22 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// UnknownTag is a tag the Reader doesn't recognize, such as those added by vendors. UnknownTags
+// are only kept when ReaderOpts.KeepUnknownTags is set and are written back unchanged by Writer.
+type UnknownTag struct {
+	// Tag is the tag number, such as {9100}
+	Tag string `json:"tag"`
+	// Data is everything following the tag number
+	Data string `json:"data,omitempty"`
+	// After is the tag which preceded the UnknownTag in the input, or empty when it began the message.
+	// Writer emits the UnknownTag following that tag.
+	After string `json:"after,omitempty"`
+}
+
+// String returns the UnknownTag as it was read
+func (ut *UnknownTag) String() string {
+	return ut.Tag + ut.Data
+}
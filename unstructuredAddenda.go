@@ -89,6 +89,11 @@ func (ua *UnstructuredAddenda) String() string {
 //	length of content in Addenda Information (e.g., if content of Addenda Information is 987 characters,
 //	Addenda Length must be 0987).
 func (ua *UnstructuredAddenda) Validate() error {
+	return ua.validate(nil)
+}
+
+// validate performs the WIRE format rule checks of Validate on UnstructuredAddenda following opts, which may be nil
+func (ua *UnstructuredAddenda) validate(opts *ValidateOpts) error {
 	if err := ua.fieldInclusion(); err != nil {
 		return err
 	}
@@ -98,7 +103,7 @@ func (ua *UnstructuredAddenda) Validate() error {
 	if err := ua.isNumeric(ua.AddendaLength); err != nil {
 		return fieldError("AddendaLength", err, ua.AddendaLength)
 	}
-	if err := ua.isAlphanumeric(ua.Addenda); err != nil {
+	if err := ua.isAlphanumeric(opts, ua.Addenda); err != nil {
 		return fieldError("Addenda", err, ua.Addenda)
 	}
 
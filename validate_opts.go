@@ -1,5 +1,10 @@
 package wire
 
+import (
+	"fmt"
+	"slices"
+)
+
 // ValidateOpts contains specific overrides from the default set of validations
 type ValidateOpts struct {
 	// SkipMandatoryIMAD skips checking that InputMessageAccountabilityData is mandatory tag.
@@ -7,4 +12,98 @@ type ValidateOpts struct {
 
 	// AllowMissingSenderSupplied allows the senderSupplied field to be omitted.
 	AllowMissingSenderSupplied bool `json:"allowMissingSenderSupplied"`
+
+	// CheckTagOrder checks that tags were read in ascending order within their group. Messages which weren't
+	// read by Reader and hold no Spans fail with ErrTagOrderUnknown, as the order of their tags isn't known.
+	CheckTagOrder bool `json:"checkTagOrder"`
+
+	// CheckBICCountry checks the country code of each SWIFT BIC is one of the ISO 3166-1 Countries.
+	CheckBICCountry bool `json:"checkBICCountry"`
+
+	// SkipAll turns off every validation, including the checks made as each tag is read.
+	SkipAll bool `json:"skipAll"`
+
+	// SkipRules turns off the named rules. Names are the Rule constants, such as RuleAlphanumeric, the
+	// FEDWireMessage field checked by a built-in rule, such as "Charges", or the Name of a ValidationRule.
+	SkipRules []string `json:"skipRules,omitempty"`
+
+	// Rules are custom checks run after the built-in validation succeeds
+	Rules []ValidationRule `json:"-"`
+}
+
+// Names of built-in rules which can be turned off with ValidateOpts.SkipRules. The rules checking
+// the relationship of each tag to the rest of the message are named after the FEDWireMessage field
+// holding the tag, such as "LocalInstrument".
+const (
+	// RuleAlphanumeric checks text only holds the characters permitted by FAIM 3.0.6
+	RuleAlphanumeric = "Alphanumeric"
+	// RuleCurrencyCode checks currency codes are ISO 4217 codes
+	RuleCurrencyCode = "CurrencyCode"
+	// RuleCountryCode checks country codes of remittance data and OriginatorOptionF are ISO 3166-1 alpha-2 codes
+	RuleCountryCode = "CountryCode"
+	// RuleABACheckDigit checks the check digit of ABA routing numbers in {3100} SenderDepositoryInstitution,
+	// {3400} ReceiverDepositoryInstitution and each FinancialInstitution identified by FEDRoutingNumber
+	RuleABACheckDigit = "ABACheckDigit"
+	// RuleBIC checks SWIFT BICs identifying a FinancialInstitution or party have the ISO 9362 structure
+	RuleBIC = "BIC"
+	// RuleIBAN checks the ISO 13616 check digits of account numbers which look like an IBAN
+	RuleIBAN = "IBAN"
+	// RuleProhibitedBankTransferTags checks tags not permitted with BusinessFunctionCode BankTransfer
+	RuleProhibitedBankTransferTags = "ProhibitedBankTransferTags"
+	// RuleProhibitedCustomerTransferPlusTags checks tags not permitted with BusinessFunctionCode CustomerTransferPlus
+	RuleProhibitedCustomerTransferPlusTags = "ProhibitedCustomerTransferPlusTags"
+	// RuleProhibitedServiceMessageTags checks tags not permitted with BusinessFunctionCode BFCServiceMessage
+	RuleProhibitedServiceMessageTags = "ProhibitedServiceMessageTags"
+	// RuleSharedProhibitedTags checks tags not permitted with the settlement and drawdown BusinessFunctionCodes
+	RuleSharedProhibitedTags = "SharedProhibitedTags"
+	// RuleProhibitedCoverPaymentTags checks {7xxx} cover payment tags are only present when permitted
+	RuleProhibitedCoverPaymentTags = "ProhibitedCoverPaymentTags"
+	// RuleProhibitedRemittanceTags checks {8xxx} remittance tags are only present when permitted
+	RuleProhibitedRemittanceTags = "ProhibitedRemittanceTags"
+)
+
+// Names of validation profiles accepted by ValidateOptsForProfile
+const (
+	// ProfileIncoming validates messages received from the Fedwire Funds Service, which omit SenderSupplied
+	ProfileIncoming = "incoming"
+	// ProfileOutgoing validates messages sent to the Fedwire Funds Service with every built-in rule
+	ProfileOutgoing = "outgoing"
+	// ProfileLenientImport accepts messages from other systems which don't follow every FAIM rule. Only the
+	// structure of each tag and the tags mandatory for each BusinessFunctionCode are checked.
+	ProfileLenientImport = "lenient-import"
+)
+
+// ValidateOptsForProfile returns new ValidateOpts for the named profile, such as ProfileIncoming
+func ValidateOptsForProfile(profile string) (*ValidateOpts, error) {
+	switch profile {
+	case ProfileIncoming:
+		return &ValidateOpts{AllowMissingSenderSupplied: true}, nil
+	case ProfileOutgoing:
+		return &ValidateOpts{}, nil
+	case ProfileLenientImport:
+		return &ValidateOpts{
+			SkipMandatoryIMAD:          true,
+			AllowMissingSenderSupplied: true,
+			SkipRules: []string{
+				RuleAlphanumeric,
+				RuleCurrencyCode,
+				RuleCountryCode,
+				RuleABACheckDigit,
+				RuleBIC,
+				RuleIBAN,
+				RuleProhibitedBankTransferTags,
+				RuleProhibitedCustomerTransferPlusTags,
+				RuleProhibitedServiceMessageTags,
+				RuleSharedProhibitedTags,
+				RuleProhibitedCoverPaymentTags,
+				RuleProhibitedRemittanceTags,
+			},
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown validation profile %q", profile)
+}
+
+// skips returns true when the named rule is turned off. opts may be nil.
+func (opts *ValidateOpts) skips(rule string) bool {
+	return opts != nil && (opts.SkipAll || slices.Contains(opts.SkipRules, rule))
 }
@@ -0,0 +1,131 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateOpts_SkipRules(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.Name = "Zoë"
+	fwm.Originator = mockOriginator()
+	fwm.InstructedAmount = mockInstructedAmount()
+	fwm.InstructedAmount.CurrencyCode = "XYZ"
+
+	err := fwm.ValidateAll()
+	require.True(t, base.Has(err, ErrNonCurrencyCode), "%v", err)
+	require.True(t, base.Has(err, ErrNonAlphanumeric), "%v", err)
+
+	fwm.ValidateOptions = &ValidateOpts{SkipRules: []string{RuleCurrencyCode}}
+	err = fwm.ValidateAll()
+	require.False(t, base.Has(err, ErrNonCurrencyCode), "%v", err)
+	require.True(t, base.Has(err, ErrNonAlphanumeric), "%v", err)
+
+	fwm.ValidateOptions.SkipRules = append(fwm.ValidateOptions.SkipRules, RuleAlphanumeric)
+	require.NoError(t, fwm.ValidateAll())
+
+	// prohibited tags
+	fwm = mockCustomerTransferData()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BankTransfer
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.IdentificationCode = SWIFTBICORBEIANDAccountNumber
+	require.ErrorIs(t, fwm.verify(), ErrInvalidProperty)
+	fwm.ValidateOptions = &ValidateOpts{SkipRules: []string{RuleProhibitedBankTransferTags}}
+	require.NoError(t, fwm.verify())
+
+	// rules named after a field
+	fwm = mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	fwm.LocalInstrument = mockLocalInstrument()
+	require.ErrorIs(t, fwm.verify(), ErrLocalInstrumentNotPermitted)
+	fwm.ValidateOptions = &ValidateOpts{SkipRules: []string{"LocalInstrument"}}
+	require.NoError(t, fwm.verify())
+}
+
+func TestValidateOpts_notKeptOnTags(t *testing.T) {
+	strict := mockCustomerTransferData()
+	strict.Beneficiary = mockBeneficiary()
+	strict.Beneficiary.Personal.Name = "Zoë"
+	strict.Originator = mockOriginator()
+
+	// both messages hold the same Beneficiary
+	lenient := strict
+	lenient.ValidateOptions = &ValidateOpts{SkipRules: []string{RuleAlphanumeric}}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			err := strict.ValidateAll()
+			require.True(t, base.Has(err, ErrNonAlphanumeric), "%v", err)
+		}()
+		go func() {
+			defer wg.Done()
+			require.NoError(t, lenient.ValidateAll())
+		}()
+	}
+	wg.Wait()
+	require.ErrorIs(t, strict.Beneficiary.Validate(), ErrNonAlphanumeric)
+}
+
+func TestValidateOpts_SkipAll(t *testing.T) {
+	fwm := FEDWireMessage{}
+	require.Error(t, fwm.verify())
+
+	fwm.ValidateOptions = &ValidateOpts{SkipAll: true, CheckTagOrder: true}
+	require.NoError(t, fwm.verify())
+	require.NoError(t, fwm.ValidateAll())
+
+	var opts *ValidateOpts
+	require.False(t, opts.skips(RuleAlphanumeric))
+}
+
+func TestValidateOptsForProfile(t *testing.T) {
+	opts, err := ValidateOptsForProfile(ProfileIncoming)
+	require.NoError(t, err)
+	require.Equal(t, &ValidateOpts{AllowMissingSenderSupplied: true}, opts)
+
+	opts, err = ValidateOptsForProfile(ProfileOutgoing)
+	require.NoError(t, err)
+	require.Equal(t, &ValidateOpts{}, opts)
+
+	opts, err = ValidateOptsForProfile(ProfileLenientImport)
+	require.NoError(t, err)
+	require.True(t, opts.SkipMandatoryIMAD)
+	require.True(t, opts.skips(RuleAlphanumeric))
+	require.True(t, opts.skips(RuleProhibitedRemittanceTags))
+	require.False(t, opts.skips("Beneficiary"))
+
+	_, err = ValidateOptsForProfile("strict")
+	require.EqualError(t, err, `unknown validation profile "strict"`)
+}
+
+func TestReader_ValidateOptsProfile(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	input := strings.Replace(string(bs), "{4200}31234*Name*", "{4200}31234*Zoë*", 1)
+	input = strings.Replace(input, "{3710}USD4567,89*", "{3710}XYZ4567,89*", 1)
+
+	_, err = NewReader(strings.NewReader(input)).Read()
+	require.True(t, base.Has(err, ErrNonAlphanumeric), "%v", err)
+
+	opts, err := ValidateOptsForProfile(ProfileLenientImport)
+	require.NoError(t, err)
+	file, err := NewReader(strings.NewReader(input)).ReadWithOpts(opts)
+	require.NoError(t, err)
+	require.Equal(t, "Zoë", file.FEDWireMessage.Beneficiary.Personal.Name)
+	require.Equal(t, "XYZ", file.FEDWireMessage.InstructedAmount.CurrencyCode)
+}
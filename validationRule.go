@@ -0,0 +1,62 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// ValidationRule is a custom check of a FEDWireMessage, such as a rule set by a bank for the wires it sends.
+// Rules are registered in ValidateOpts.Rules and run after the built-in validation succeeds.
+type ValidationRule interface {
+	// Name identifies the rule in errors which aren't a *FieldError
+	Name() string
+
+	// Validate returns an error when fwm breaks the rule. Errors should be a *FieldError naming the
+	// FEDWireMessage field which is at fault, such as one created by NewFieldError.
+	Validate(fwm *FEDWireMessage) error
+}
+
+// NewValidationRule returns a ValidationRule named name which is checked by calling validate
+func NewValidationRule(name string, validate func(fwm *FEDWireMessage) error) ValidationRule {
+	return &validationRule{name: name, validate: validate}
+}
+
+// validationRule is a ValidationRule implemented by a function
+type validationRule struct {
+	name     string
+	validate func(fwm *FEDWireMessage) error
+}
+
+func (r *validationRule) Name() string {
+	return r.name
+}
+
+func (r *validationRule) Validate(fwm *FEDWireMessage) error {
+	return r.validate(fwm)
+}
+
+// NewFieldError returns a *FieldError for err found in field, which is a FEDWireMessage field such as
+// Beneficiary or a path within one such as Beneficiary.Personal.Name. value is optional.
+func NewFieldError(field string, err error, value ...interface{}) *FieldError {
+	fe := &FieldError{FieldName: field, Err: err}
+	if len(value) > 0 {
+		fe.Value = value[0]
+	}
+	return fe
+}
+
+// customRules returns the ValidationRules registered in fwm.ValidateOptions
+func (fwm *FEDWireMessage) customRules() []messageRule {
+	if fwm.ValidateOptions == nil {
+		return nil
+	}
+	rules := make([]messageRule, 0, len(fwm.ValidateOptions.Rules))
+	for _, rule := range fwm.ValidateOptions.Rules {
+		if rule == nil {
+			continue
+		}
+		rules = append(rules, messageRule{rule.Name(), func(fwm *FEDWireMessage) error {
+			return fieldError(rule.Name(), rule.Validate(fwm))
+		}})
+	}
+	return rules
+}
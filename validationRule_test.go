@@ -0,0 +1,93 @@
+package wire
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+// largeCTRRule requires {6000} on customer transfers over $1,000,000
+type largeCTRRule struct{}
+
+func (largeCTRRule) Name() string {
+	return "LargeCTRRequiresOriginatorToBeneficiary"
+}
+
+func (largeCTRRule) Validate(fwm *FEDWireMessage) error {
+	cents, err := strconv.ParseInt(fwm.Amount.Amount, 10, 64)
+	if err != nil {
+		return NewFieldError("Amount", err, fwm.Amount.Amount)
+	}
+	if fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransfer && cents > 100000000 && fwm.OriginatorToBeneficiary == nil {
+		return NewFieldError("OriginatorToBeneficiary", ErrFieldRequired)
+	}
+	return nil
+}
+
+var errSharedCharges = errors.New("shared charges are not allowed")
+
+// noSharedChargesRule rejects {3700} Charges
+var noSharedChargesRule = NewValidationRule("NoSharedCharges", func(fwm *FEDWireMessage) error {
+	if fwm.Charges != nil && fwm.Charges.ChargeDetails == "S" {
+		return errSharedCharges
+	}
+	return nil
+})
+
+func TestValidationRule(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	fwm.Amount.Amount = "000200000000"
+	fwm.Charges = mockCharges()
+	fwm.Charges.ChargeDetails = "S"
+	require.NoError(t, fwm.verify())
+
+	fwm.ValidateOptions = &ValidateOpts{Rules: []ValidationRule{largeCTRRule{}, noSharedChargesRule}}
+	err := fwm.verify()
+	require.EqualError(t, err, "OriginatorToBeneficiary is a required field")
+	require.ErrorIs(t, err, ErrFieldRequired)
+
+	err = fwm.ValidateAll()
+	var el base.ErrorList
+	require.ErrorAs(t, err, &el)
+	require.Len(t, el, 2)
+	require.Equal(t, TagOriginatorToBeneficiary, el[0].(*FieldError).Tag)
+	// errors which aren't a FieldError are named after their rule
+	require.Equal(t, "NoSharedCharges", el[1].(*FieldError).FieldName)
+	require.ErrorIs(t, el[1], errSharedCharges)
+
+	fwm.OriginatorToBeneficiary = mockOriginatorToBeneficiary()
+	fwm.Charges.ChargeDetails = "B"
+	require.NoError(t, fwm.ValidateAll())
+
+	// rules are run on each message of a File
+	fwm.ValidateOptions = nil
+	file := NewFile()
+	file.AddFEDWireMessage(fwm)
+	file.AddFEDWireMessage(fwm)
+	file.AdditionalFEDWireMessages[0].OriginatorToBeneficiary = nil
+	require.NoError(t, file.Validate())
+	file.SetValidation(&ValidateOpts{Rules: []ValidationRule{largeCTRRule{}}})
+	require.True(t, base.Has(file.Validate(), &MessageError{}))
+	require.ErrorContains(t, file.Validate(), "message 2: OriginatorToBeneficiary is a required field")
+}
+
+func TestValidationRule_builtInFirst(t *testing.T) {
+	called := false
+	fwm := mockCustomerTransferData()
+	fwm.ValidateOptions = &ValidateOpts{Rules: []ValidationRule{
+		NewValidationRule("called", func(*FEDWireMessage) error {
+			called = true
+			return nil
+		}),
+	}}
+
+	// Beneficiary is missing
+	require.Error(t, fwm.verify())
+	require.Error(t, fwm.ValidateAll())
+	require.False(t, called)
+}
@@ -22,6 +22,13 @@ var (
 
 	numericRegex = regexp.MustCompile(`[^0-9]`)
 	amountRegex  = regexp.MustCompile("[^0-9,.]")
+
+	// ISO 9362: 4 character institution code, 2 letter country code, 2 character location code
+	// and an optional 3 character branch code
+	bicRegex = regexp.MustCompile(`^[A-Z0-9]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+
+	// ISO 13616: 2 letter country code, 2 check digits and up to 30 characters of account number
+	ibanRegex = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`)
 )
 
 const (
@@ -43,7 +50,10 @@ func validSizeUint(n uint) bool {
 type validator struct{}
 
 // isAlphanumeric checks if a string only contains ASCII alphanumeric characters
-func (v *validator) isAlphanumeric(s string) error {
+func (v *validator) isAlphanumeric(opts *ValidateOpts, s string) error {
+	if opts.skips(RuleAlphanumeric) {
+		return nil
+	}
 	if alphanumericRegex.MatchString(s) {
 		return ErrNonAlphanumeric
 	}
@@ -349,7 +359,10 @@ func (v *validator) isAdjustmentReasonCode(code string) error {
 	return ErrAdjustmentReasonCode
 }
 
-func (v *validator) isCurrencyCode(code string) error {
+func (v *validator) isCurrencyCode(opts *ValidateOpts, code string) error {
+	if opts.skips(RuleCurrencyCode) {
+		return nil
+	}
 	_, err := currency.ParseISO(code)
 	if err != nil {
 		return ErrNonCurrencyCode
@@ -357,6 +370,107 @@ func (v *validator) isCurrencyCode(code string) error {
 	return nil
 }
 
+// isCountryCode checks code is an ISO 3166-1 alpha-2 country code in Countries
+func (v *validator) isCountryCode(opts *ValidateOpts, code string) error {
+	if opts.skips(RuleCountryCode) {
+		return nil
+	}
+	if !countryCodes[code] {
+		return ErrNonCountryCode
+	}
+	return nil
+}
+
+// isABANumber checks s is a nine digit ABA routing number with a valid check digit, which makes the weighted
+// sum of its digits a multiple of 10 using the weights 3, 7 and 1
+func (v *validator) isABANumber(opts *ValidateOpts, s string) error {
+	if opts.skips(RuleABACheckDigit) {
+		return nil
+	}
+	if len(s) != 9 || !isDigits(s) {
+		return ErrABANumber
+	}
+	weights := [3]int{3, 7, 1}
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		sum += int(s[i]-'0') * weights[i%3]
+	}
+	if sum%10 != 0 {
+		return ErrABANumber
+	}
+	return nil
+}
+
+// isBIC checks s has the structure of an ISO 9362 SWIFT BIC. The country code of the BIC is checked
+// when ValidateOpts.CheckBICCountry is set.
+func (v *validator) isBIC(opts *ValidateOpts, s string) error {
+	if opts.skips(RuleBIC) {
+		return nil
+	}
+	if !bicRegex.MatchString(s) {
+		return ErrBIC
+	}
+	if opts != nil && opts.CheckBICCountry && !countryCodes[s[4:6]] {
+		return ErrBIC
+	}
+	return nil
+}
+
+// validateIBAN checks the ISO 13616 mod-97 check digits of s when it looks like an IBAN. Account numbers
+// which aren't IBANs are not checked.
+func (v *validator) validateIBAN(opts *ValidateOpts, s string) error {
+	if opts.skips(RuleIBAN) || !ibanRegex.MatchString(s) {
+		return nil
+	}
+	// the country code and check digits are moved to the end, and letters are numbered from A=10 to Z=35
+	remainder := 0
+	for _, c := range s[4:] + s[:4] {
+		if c >= 'A' {
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(c-'0')) % 97
+		}
+	}
+	if remainder != 1 {
+		return ErrIBAN
+	}
+	return nil
+}
+
+// validateAccountIdentifier checks identifier is a BIC for SWIFTBankIdentifierCode, and the IBAN of a
+// DemandDepositAccountNumber. A SWIFTBICORBEIANDAccountNumber identifier is the BIC or BEI followed
+// by "/" and the account number, or only the account number.
+func (v *validator) validateAccountIdentifier(opts *ValidateOpts, code, identifier string) error {
+	switch code {
+	case SWIFTBankIdentifierCode:
+		return v.isBIC(opts, identifier)
+	case SWIFTBICORBEIANDAccountNumber:
+		if bic, account, ok := strings.Cut(identifier, "/"); ok {
+			if err := v.isBIC(opts, bic); err != nil {
+				return err
+			}
+			return v.validateIBAN(opts, account)
+		}
+		return v.validateIBAN(opts, identifier)
+	case DemandDepositAccountNumber:
+		return v.validateIBAN(opts, identifier)
+	}
+	return nil
+}
+
+// validateSwiftAccountLine checks the IBAN of a SWIFT party field line starting with "/", which holds
+// the account number, such as "/DE89370400440532013000" or "/D/DE89370400440532013000"
+func (v *validator) validateSwiftAccountLine(opts *ValidateOpts, line string) error {
+	account, ok := strings.CutPrefix(line, "/")
+	if !ok {
+		return nil
+	}
+	if code, rest, ok := strings.Cut(account, "/"); ok && (code == "C" || code == "D") {
+		account = rest
+	}
+	return v.validateIBAN(opts, strings.TrimSpace(account))
+}
+
 // isCentury validates a 2 digit century 20-29
 func (v *validator) isCentury(s string) error {
 	if s < "20" || s > "29" {
@@ -450,7 +564,7 @@ func (v *validator) validateDate(s string) error {
 
 // validatePartyIdentifier validates that PartyIdentifier must be one of the following two formats:
 // 1. /Account Number (slash followed by at least one valid non-space character:  e.g., /123456)
-func (v *validator) validatePartyIdentifier(s string) error {
+func (v *validator) validatePartyIdentifier(opts *ValidateOpts, s string) error {
 	if s == "" {
 		return ErrPartyIdentifier
 	}
@@ -463,11 +577,11 @@ func (v *validator) validatePartyIdentifier(s string) error {
 			return ErrPartyIdentifier
 		}
 		an := s[2:]
-		if alphanumericRegex.MatchString(an) {
+		if v.isAlphanumeric(opts, an) != nil {
 			return ErrPartyIdentifier
 		}
 	} else {
-		if err := v.validateUIDPartyIdentifier(s); err != nil {
+		if err := v.validateUIDPartyIdentifier(opts, s); err != nil {
 			return err
 		}
 	}
@@ -486,7 +600,7 @@ func (v *validator) validatePartyIdentifier(s string) error {
 // NIDN: National Identify Number
 // SOSE: Social Security Number
 // TXID: Tax Identification Number
-func (v *validator) validateUIDPartyIdentifier(s string) error {
+func (v *validator) validateUIDPartyIdentifier(opts *ValidateOpts, s string) error {
 	if utf8.RuneCountInString(s) < 7 {
 		return ErrPartyIdentifier
 	}
@@ -511,7 +625,7 @@ func (v *validator) validateUIDPartyIdentifier(s string) error {
 		return ErrPartyIdentifier
 	}
 	an := s[5:]
-	if alphanumericRegex.MatchString(an) {
+	if v.isAlphanumeric(opts, an) != nil {
 		return ErrPartyIdentifier
 	}
 	return nil
@@ -532,7 +646,7 @@ func (v *validator) validateUIDPartyIdentifier(s string) error {
 // 2/123 MAIN STREET
 // 3/US/NEW YORK, NY 10000
 // 7/111-22-3456
-func (v *validator) validateOptionFLine(s string) error {
+func (v *validator) validateOptionFLine(opts *ValidateOpts, s string) error {
 	if s == "" {
 		return nil
 	}
@@ -560,19 +674,30 @@ func (v *validator) validateOptionFLine(s string) error {
 		return ErrOptionFLine
 	}
 	an := strings.TrimSpace(s[2:])
-	if alphanumericRegex.MatchString(an) {
+	if v.isAlphanumeric(opts, an) != nil {
 		return ErrOptionFLine
 	}
 	return nil
 }
 
+// validateOptionFCountryTown validates the country code of an OriginatorOptionF Country and Town line
+// Format:
+//
+//	Line Code 3 followed by a slash, an ISO 3166-1 alpha-2 country code, a slash and the town:
+//
+// e.g., 3/US/NEW YORK
+func (v *validator) validateOptionFCountryTown(opts *ValidateOpts, s string) error {
+	country, _, _ := strings.Cut(s[2:], "/")
+	return v.isCountryCode(opts, country)
+}
+
 // validateOptionFName validates OriginatorOptionF
 // Name  Format:
 //
 //	Must begin with Line Code 1 followed by a slash and at least one valid non-space character:
 //
 // e.g., 1/SMITH JOHN.
-func (v *validator) validateOptionFName(s string) error {
+func (v *validator) validateOptionFName(opts *ValidateOpts, s string) error {
 	if utf8.RuneCountInString(s) < 3 {
 		return ErrOptionFName
 	}
@@ -586,7 +711,7 @@ func (v *validator) validateOptionFName(s string) error {
 		return ErrOptionFName
 	}
 	an := strings.TrimSpace(s[2:])
-	if alphanumericRegex.MatchString(an) {
+	if v.isAlphanumeric(opts, an) != nil {
 		return ErrOptionFName
 	}
 	return nil
@@ -43,17 +43,95 @@ func TestValidSize(t *testing.T) {
 func TestValidators__validateOptionFName(t *testing.T) {
 	v := &validator{}
 
-	require.NoError(t, v.validateOptionFName("1/SMITH JOHN"))
-	require.Error(t, v.validateOptionFName("1/"))
-	require.Error(t, v.validateOptionFName("1"))
-	require.Error(t, v.validateOptionFName(""))
-	require.Error(t, v.validateOptionFName(" /"))
+	require.NoError(t, v.validateOptionFName(nil, "1/SMITH JOHN"))
+	require.Error(t, v.validateOptionFName(nil, "1/"))
+	require.Error(t, v.validateOptionFName(nil, "1"))
+	require.Error(t, v.validateOptionFName(nil, ""))
+	require.Error(t, v.validateOptionFName(nil, " /"))
 }
 
 func TestValidators__isAlphanumeric(t *testing.T) {
 	v := &validator{}
 
-	require.NoError(t, v.isAlphanumeric("Telepathic Bank (U.K.) / Acct #12345-ABC"))
-	require.Error(t, v.isAlphanumeric("{1100}"))
-	require.Error(t, v.isAlphanumeric("*"))
+	require.NoError(t, v.isAlphanumeric(nil, "Telepathic Bank (U.K.) / Acct #12345-ABC"))
+	require.Error(t, v.isAlphanumeric(nil, "{1100}"))
+	require.Error(t, v.isAlphanumeric(nil, "*"))
+}
+
+func TestValidators__isABANumber(t *testing.T) {
+	v := &validator{}
+	var opts *ValidateOpts
+
+	require.NoError(t, v.isABANumber(opts, "121042882"))
+	require.NoError(t, v.isABANumber(opts, "021000089"))
+	require.ErrorIs(t, v.isABANumber(opts, "121042881"), ErrABANumber)
+	require.ErrorIs(t, v.isABANumber(opts, "12104288"), ErrABANumber)
+	require.ErrorIs(t, v.isABANumber(opts, "12104288A"), ErrABANumber)
+
+	opts = &ValidateOpts{SkipRules: []string{RuleABACheckDigit}}
+	require.NoError(t, v.isABANumber(opts, "121042881"))
+}
+
+func TestValidators__isCountryCode(t *testing.T) {
+	v := &validator{}
+	var opts *ValidateOpts
+
+	require.NoError(t, v.isCountryCode(opts, "US"))
+	require.NoError(t, v.isCountryCode(opts, "UA"))
+	require.ErrorIs(t, v.isCountryCode(opts, "us"), ErrNonCountryCode)
+	require.ErrorIs(t, v.isCountryCode(opts, "UK"), ErrNonCountryCode)
+	require.ErrorIs(t, v.isCountryCode(opts, "USA"), ErrNonCountryCode)
+
+	opts = &ValidateOpts{SkipRules: []string{RuleCountryCode}}
+	require.NoError(t, v.isCountryCode(opts, "UK"))
+}
+
+func TestValidators__isBIC(t *testing.T) {
+	v := &validator{}
+	var opts *ValidateOpts
+
+	require.NoError(t, v.isBIC(opts, "DEUTDEFF"))
+	require.NoError(t, v.isBIC(opts, "CITIUS33XXX"))
+	require.NoError(t, v.isBIC(opts, "CITIZZ33"))
+	require.ErrorIs(t, v.isBIC(opts, "DEUTDEF"), ErrBIC)
+	require.ErrorIs(t, v.isBIC(opts, "DEUT1EFF"), ErrBIC)
+	require.ErrorIs(t, v.isBIC(opts, "citius33"), ErrBIC)
+	require.ErrorIs(t, v.isBIC(opts, "CITIUS33XX"), ErrBIC)
+
+	opts = &ValidateOpts{CheckBICCountry: true}
+	require.NoError(t, v.isBIC(opts, "CITIUS33XXX"))
+	require.ErrorIs(t, v.isBIC(opts, "CITIZZ33"), ErrBIC)
+
+	opts = &ValidateOpts{SkipRules: []string{RuleBIC}}
+	require.NoError(t, v.isBIC(opts, "DEUTDEF"))
+}
+
+func TestValidators__validateIBAN(t *testing.T) {
+	v := &validator{}
+	var opts *ValidateOpts
+
+	require.NoError(t, v.validateIBAN(opts, "DE89370400440532013000"))
+	require.NoError(t, v.validateIBAN(opts, "GB82WEST12345698765432"))
+	require.ErrorIs(t, v.validateIBAN(opts, "DE89370400440532013001"), ErrIBAN)
+	require.ErrorIs(t, v.validateIBAN(opts, "GB28WEST12345698765432"), ErrIBAN)
+
+	// account numbers which aren't IBANs are not checked
+	require.NoError(t, v.validateIBAN(opts, "123456789"))
+	require.NoError(t, v.validateIBAN(opts, "DE89"))
+
+	opts = &ValidateOpts{SkipRules: []string{RuleIBAN}}
+	require.NoError(t, v.validateIBAN(opts, "DE89370400440532013001"))
+}
+
+func TestValidators__validateAccountIdentifier(t *testing.T) {
+	v := &validator{}
+
+	require.NoError(t, v.validateAccountIdentifier(nil, SWIFTBankIdentifierCode, "DEUTDEFF"))
+	require.ErrorIs(t, v.validateAccountIdentifier(nil, SWIFTBankIdentifierCode, "1"), ErrBIC)
+	require.NoError(t, v.validateAccountIdentifier(nil, SWIFTBICORBEIANDAccountNumber, "DEUTDEFF/DE89370400440532013000"))
+	require.NoError(t, v.validateAccountIdentifier(nil, SWIFTBICORBEIANDAccountNumber, "000100011"))
+	require.ErrorIs(t, v.validateAccountIdentifier(nil, SWIFTBICORBEIANDAccountNumber, "DEUT/000100011"), ErrBIC)
+	require.ErrorIs(t, v.validateAccountIdentifier(nil, SWIFTBICORBEIANDAccountNumber, "DEUTDEFF/DE89370400440532013001"), ErrIBAN)
+	require.ErrorIs(t, v.validateAccountIdentifier(nil, DemandDepositAccountNumber, "DE89370400440532013001"), ErrIBAN)
+	require.NoError(t, v.validateAccountIdentifier(nil, FEDRoutingNumber, "DE89370400440532013001"))
 }
@@ -9,6 +9,8 @@ import (
 	"io"
 	"slices"
 	"strings"
+
+	"golang.org/x/text/encoding"
 )
 
 // A Writer writes an fedWireMessage to an encoded file.
@@ -18,8 +20,9 @@ import (
 
 // Writer struct
 type Writer struct {
-	w       *bufio.Writer
-	lineNum int // current line being written
+	w        *bufio.Writer
+	lineNum  int               // current line being written
+	encoding encoding.Encoding // character set written, UTF-8 when nil
 	FormatOptions
 }
 
@@ -39,6 +42,21 @@ func NewlineCharacter(newline string) OptionFunc {
 	}
 }
 
+// WithFormatOptions specify all of the FormatOptions, such as those detected by Reader
+func WithFormatOptions(options FormatOptions) OptionFunc {
+	return func(w *Writer) {
+		w.FormatOptions = options
+	}
+}
+
+// Encoding specify the character set to write, such as charmap.CodePage037 or charmap.CodePage1047
+// for EBCDIC files
+func Encoding(enc encoding.Encoding) OptionFunc {
+	return func(w *Writer) {
+		w.encoding = enc
+	}
+}
+
 // NewWriter returns a new Writer that writes to w.
 // If no opts are provided, the writer will default to fixed-length fields and use "\n" for newlines.
 func NewWriter(w io.Writer, opts ...OptionFunc) *Writer {
@@ -52,11 +70,14 @@ func NewWriter(w io.Writer, opts ...OptionFunc) *Writer {
 	for _, opt := range opts {
 		opt(writer)
 	}
+	if writer.encoding != nil {
+		writer.w = bufio.NewWriter(writer.encoding.NewEncoder().Writer(w))
+	}
 
 	return writer
 }
 
-// Writer writes a single FEDWireMessage record to w
+// Write writes each FEDWireMessage in file to w
 // options
 //
 //	first bool : has variable length
@@ -66,11 +87,16 @@ func (w *Writer) Write(file *File) error {
 		return err
 	}
 	w.lineNum = 0
-	// Iterate over all records in the file
-	if err := w.writeFEDWireMessage(file); err != nil {
-		return err
+	// Iterate over all messages in the file
+	for i, fwm := range file.Messages() {
+		if err := w.writeFEDWireMessage(*fwm); err != nil {
+			if len(file.AdditionalFEDWireMessages) > 0 {
+				return &MessageError{Index: i, Err: err}
+			}
+			return err
+		}
+		w.lineNum++
 	}
-	w.lineNum++
 
 	return w.w.Flush()
 }
@@ -82,9 +108,7 @@ func (w *Writer) Flush() error {
 	return w.w.Flush()
 }
 
-func (w *Writer) writeFEDWireMessage(file *File) error {
-	fwm := file.FEDWireMessage
-
+func (w *Writer) writeFEDWireMessage(fwm FEDWireMessage) error {
 	var outputLines []string
 
 	mandatoryLines, err := w.writeMandatory(fwm)
@@ -144,12 +168,75 @@ func (w *Writer) writeFEDWireMessage(file *File) error {
 	outputLines = append(outputLines, fedAppendedLines...)
 
 	slices.Sort(outputLines)
+	outputLines = insertUnknownTags(outputLines, fwm.UnknownTags)
+	if fwm.SourceFormat != nil {
+		w.writeSourceFormat(fwm, outputLines)
+		return nil
+	}
 	w.w.WriteString(strings.Join(outputLines, w.NewlineCharacter))
 	w.w.WriteString(w.NewlineCharacter)
 
 	return nil
 }
 
+// writeSourceFormat writes the tags of fwm as they were read, following fwm.SourceFormat. Tags from
+// lines which were not read are written afterwards.
+func (w *Writer) writeSourceFormat(fwm FEDWireMessage, lines []string) {
+	written := make(map[string]int)
+	for _, tf := range fwm.SourceFormat.Tags {
+		var tag interface{ String() string }
+		if record := fwm.tagRecord(tf.Tag); record != nil {
+			tag = record
+		} else {
+			// unknown tags are matched in the order they were read
+			seen := 0
+			for i := range fwm.UnknownTags {
+				if fwm.UnknownTags[i].Tag != tf.Tag {
+					continue
+				}
+				if seen == written[tf.Tag] {
+					tag = &fwm.UnknownTags[i]
+					break
+				}
+				seen++
+			}
+		}
+		if tag == nil || (written[tf.Tag] > 0 && fwm.tagRecord(tf.Tag) != nil) {
+			// the tag has been removed
+			continue
+		}
+		w.w.WriteString(tf.Prefix + tf.format(tag) + tf.Suffix)
+		written[tf.Tag]++
+	}
+
+	for _, line := range lines {
+		if written[line[:6]] > 0 {
+			written[line[:6]]--
+			continue
+		}
+		w.w.WriteString(line + w.NewlineCharacter)
+	}
+}
+
+// insertUnknownTags places each UnknownTag into lines following the tag it was read after. UnknownTags
+// whose preceding tag is missing from lines are written last.
+func insertUnknownTags(lines []string, tags []UnknownTag) []string {
+	for _, ut := range tags {
+		index := 0
+		if ut.After != "" {
+			index = len(lines)
+			for i := len(lines) - 1; i >= 0; i-- {
+				if strings.HasPrefix(lines[i], ut.After) {
+					index = i + 1
+					break
+				}
+			}
+		}
+		lines = slices.Insert(lines, index, ut.String())
+	}
+	return lines
+}
+
 func (w *Writer) writeFedAppended(fwm FEDWireMessage) ([]string, error) {
 	var lines []string
 
@@ -2,10 +2,13 @@ package wire
 
 import (
 	"bytes"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/charmap"
 )
 
 func TestSenderSupplied_Mandatory(t *testing.T) {
@@ -1340,3 +1343,124 @@ func TestFEDWireMessageWriteCustomerTransferPlusUnstructuredAddenda(t *testing.T
 
 	require.NoError(t, writeFile(file))
 }
+
+func TestWriter_multipleMessages(t *testing.T) {
+	fd, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-MultipleMessages.txt"))
+	require.NoError(t, err)
+	defer fd.Close()
+
+	file, err := NewReader(fd).Read()
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, NewWriter(&buf).Write(&file))
+
+	// reading the output again gives back the same messages
+	out, err := NewReader(&buf).Read()
+	require.NoError(t, err)
+	require.Len(t, out.Messages(), 3)
+	for i, fwm := range out.Messages() {
+		require.Equal(t, file.Messages()[i].BusinessFunctionCode, fwm.BusinessFunctionCode)
+		require.Equal(t, file.Messages()[i].Amount, fwm.Amount)
+	}
+}
+
+func TestWriter_unknownTags(t *testing.T) {
+	f, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-fiservUnknownTags.txt"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	r := NewReader(f)
+	r.SetOptions(&ReaderOpts{KeepUnknownTags: true})
+	file, err := r.Read()
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, NewWriter(&buf, VariableLengthFields(true), NewlineCharacter("")).Write(&file))
+
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-fiservUnknownTags.txt"))
+	require.NoError(t, err)
+	require.Equal(t, string(bs), buf.String())
+}
+
+func TestWriter_insertUnknownTags(t *testing.T) {
+	lines := []string{"{1500}a", "{1510}b", "{2000}c"}
+	tags := []UnknownTag{
+		{Tag: "{1000}", Data: "first"},
+		{Tag: "{1990}", Data: "x", After: "{1510}"},
+		{Tag: "{9999}", Data: "missing", After: "{4200}"},
+	}
+	require.Equal(t, []string{"{1000}first", "{1500}a", "{1510}b", "{1990}x", "{2000}c", "{9999}missing"}, insertUnknownTags(lines, tags))
+}
+
+func TestWriter_sourceFormat(t *testing.T) {
+	matches, err := filepath.Glob(filepath.Join("test", "testdata", "fedWireMessage-*.txt"))
+	require.NoError(t, err)
+
+	for _, match := range matches {
+		bs, err := os.ReadFile(match)
+		require.NoError(t, err)
+
+		for name, input := range map[string][]byte{
+			"LF":   bs,
+			"CRLF": bytes.ReplaceAll(bs, []byte("\n"), []byte("\r\n")),
+		} {
+			t.Run(filepath.Base(match)+"/"+name, func(t *testing.T) {
+				r := NewReader(bytes.NewReader(input))
+				r.SetOptions(&ReaderOpts{PreserveFormat: true, KeepUnknownTags: true})
+				file, err := r.Read()
+				if err != nil {
+					t.Skipf("invalid file: %v", err)
+				}
+
+				var buf bytes.Buffer
+				require.NoError(t, NewWriter(&buf).Write(&file))
+				require.Equal(t, string(input), buf.String())
+			})
+		}
+	}
+}
+
+func TestWriter_sourceFormatModified(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-fiserv.txt"))
+	require.NoError(t, err)
+
+	r := NewReader(bytes.NewReader(bs))
+	r.SetOptions(&ReaderOpts{PreserveFormat: true})
+	file, err := r.Read()
+	require.NoError(t, err)
+
+	// modified tags keep the variable length format they were read in
+	file.FEDWireMessage.Beneficiary.Personal.Name = "New Name"
+	// removed tags are not written
+	file.FEDWireMessage.FIAdditionalFIToFI = nil
+	// added tags are written after those which were read
+	file.FEDWireMessage.SenderReference = mockSenderReference()
+
+	var buf bytes.Buffer
+	require.NoError(t, NewWriter(&buf).Write(&file))
+
+	expected := strings.Replace(string(bs), "*Test Name*", "*New Name*", 1)
+	expected = strings.Replace(expected, "{6500}Test*", "", 1)
+	expected += file.FEDWireMessage.SenderReference.String() + "\n"
+	require.Equal(t, expected, buf.String())
+}
+
+func TestWriter_encoding(t *testing.T) {
+	file := NewFile()
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	file.AddFEDWireMessage(fwm)
+
+	var expected bytes.Buffer
+	require.NoError(t, NewWriter(&expected).Write(file))
+
+	var buf bytes.Buffer
+	require.NoError(t, NewWriter(&buf, Encoding(charmap.CodePage1047)).Write(file))
+	require.NotEqual(t, expected.String(), buf.String())
+
+	decoded, err := charmap.CodePage1047.NewDecoder().Bytes(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, expected.String(), string(decoded))
+}